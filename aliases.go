@@ -0,0 +1,227 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// aliasAction represents an action on an alias, serialized as add, remove or remove_index
+type aliasAction struct {
+	Add         *aliasActionBody `json:"add,omitempty"`
+	Remove      *aliasActionBody `json:"remove,omitempty"`
+	RemoveIndex *aliasActionBody `json:"remove_index,omitempty"`
+}
+
+type aliasActionBody struct {
+	Index string `json:"index"`
+	Alias string `json:"alias,omitempty"`
+	AliasDefinition
+}
+
+// AliasActions represents a list of alias actions applied atomically by UpdateAliases
+type AliasActions struct {
+	actions []aliasAction
+}
+
+// NewAliasActions returns an empty list of alias actions
+func NewAliasActions() *AliasActions {
+	return &AliasActions{actions: []aliasAction{}}
+}
+
+// Add points the alias to the index, with the filter, routing and write index settings of def
+func (a *AliasActions) Add(index, alias string, def AliasDefinition) *AliasActions {
+	a.actions = append(a.actions, aliasAction{Add: &aliasActionBody{Index: index, Alias: alias, AliasDefinition: def}})
+	return a
+}
+
+// Remove removes the alias from the index
+func (a *AliasActions) Remove(index, alias string) *AliasActions {
+	a.actions = append(a.actions, aliasAction{Remove: &aliasActionBody{Index: index, Alias: alias}})
+	return a
+}
+
+// RemoveIndex deletes the index, typically to replace it by an alias of the same name
+func (a *AliasActions) RemoveIndex(index string) *AliasActions {
+	a.actions = append(a.actions, aliasAction{RemoveIndex: &aliasActionBody{Index: index}})
+	return a
+}
+
+// MarshalJSON encodes the actions as the body of the aliases API
+func (a *AliasActions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Actions []aliasAction `json:"actions"`
+	}{a.actions})
+}
+
+// Aliases represents the aliases of indices, by index name then alias name
+type Aliases map[string]map[string]AliasDefinition
+
+func (c *client) UpdateAliases(ctx context.Context, actions *AliasActions) (*Response, error) {
+	body, err := json.Marshal(actions)
+	if err != nil {
+		return &Response{}, err
+	}
+
+	url := c.Host.String() + "/_aliases"
+	response, err := sendHTTPRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body), c.Timeout)
+	if err != nil {
+		return &Response{}, err
+	}
+
+	esResp := &Response{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &Response{}, err
+	}
+
+	return esResp, nil
+}
+
+func (c *client) GetAliases(ctx context.Context, aliases ...string) (Aliases, error) {
+	url := c.Host.String() + "/_alias"
+	if len(aliases) > 0 {
+		url += "/" + strings.Join(aliases, ",")
+	}
+	response, err := sendHTTPRequestWithContext(ctx, "GET", url, nil, c.Timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	var esResp map[string]struct {
+		Aliases map[string]AliasDefinition `json:"aliases"`
+	}
+	err = json.Unmarshal(response, &esResp)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(Aliases, len(esResp))
+	for index, indexAliases := range esResp {
+		result[index] = indexAliases.Aliases
+	}
+	return result, nil
+}
+
+func (c *client) AliasExists(ctx context.Context, aliases ...string) (bool, error) {
+	if strings.Join(aliases, "") == "" {
+		return false, ErrMissingName
+	}
+
+	url := c.Host.String() + "/_alias/" + strings.Join(aliases, ",")
+	return sendHeadRequest(ctx, url, c.Timeout)
+}
+
+// AliasInfo represents an alias and the indices it points to.
+// WriteIndex is the index receiving the writes made through the alias, empty when there is none.
+type AliasInfo struct {
+	Name       string
+	Indices    []string
+	WriteIndex string
+}
+
+// ResolvedIndex represents an index matching the patterns of ResolveIndex, with its sorted aliases
+type ResolvedIndex struct {
+	Name       string   `json:"name"`
+	Aliases    []string `json:"aliases,omitempty"`
+	Attributes []string `json:"attributes"`
+	DataStream string   `json:"data_stream,omitempty"`
+}
+
+// ResolvedAlias represents an alias matching the patterns of ResolveIndex, with its sorted indices
+type ResolvedAlias struct {
+	Name    string   `json:"name"`
+	Indices []string `json:"indices"`
+}
+
+// ResolvedDataStream represents a data stream matching the patterns of ResolveIndex
+type ResolvedDataStream struct {
+	Name           string   `json:"name"`
+	BackingIndices []string `json:"backing_indices"`
+	TimestampField string   `json:"timestamp_field"`
+}
+
+// ResolvedIndices represents the result of ResolveIndex
+type ResolvedIndices struct {
+	Indices     []ResolvedIndex      `json:"indices"`
+	Aliases     []ResolvedAlias      `json:"aliases"`
+	DataStreams []ResolvedDataStream `json:"data_streams"`
+}
+
+func (c *client) GetAlias(ctx context.Context, patterns ...string) ([]AliasInfo, error) {
+	aliases, err := c.GetAliases(ctx, patterns...)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := map[string]*AliasInfo{}
+	for index, indexAliases := range aliases {
+		for name, def := range indexAliases {
+			info, ok := byName[name]
+			if !ok {
+				info = &AliasInfo{Name: name}
+				byName[name] = info
+			}
+			info.Indices = append(info.Indices, index)
+			if def.IsWriteIndex != nil && *def.IsWriteIndex {
+				info.WriteIndex = index
+			}
+		}
+	}
+
+	result := make([]AliasInfo, 0, len(byName))
+	for name, info := range byName {
+		sort.Strings(info.Indices)
+		// An alias on a single index writes to it unless is_write_index is explicitly false
+		if info.WriteIndex == "" && len(info.Indices) == 1 {
+			def := aliases[info.Indices[0]][name]
+			if def.IsWriteIndex == nil {
+				info.WriteIndex = info.Indices[0]
+			}
+		}
+		result = append(result, *info)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
+
+func (c *client) ResolveIndex(ctx context.Context, patterns ...string) (*ResolvedIndices, error) {
+	if strings.Join(patterns, "") == "" {
+		return &ResolvedIndices{}, ErrMissingIndex
+	}
+
+	url := c.Host.String() + "/_resolve/index/" + strings.Join(patterns, ",")
+	response, err := sendHTTPRequestWithContext(ctx, "GET", url, nil, c.Timeout)
+	if err != nil {
+		return &ResolvedIndices{}, err
+	}
+
+	esResp := &ResolvedIndices{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &ResolvedIndices{}, err
+	}
+
+	sort.Slice(esResp.Indices, func(i, j int) bool {
+		return esResp.Indices[i].Name < esResp.Indices[j].Name
+	})
+	for _, index := range esResp.Indices {
+		sort.Strings(index.Aliases)
+	}
+	sort.Slice(esResp.Aliases, func(i, j int) bool {
+		return esResp.Aliases[i].Name < esResp.Aliases[j].Name
+	})
+	for _, alias := range esResp.Aliases {
+		sort.Strings(alias.Indices)
+	}
+	sort.Slice(esResp.DataStreams, func(i, j int) bool {
+		return esResp.DataStreams[i].Name < esResp.DataStreams[j].Name
+	})
+
+	return esResp, nil
+}
@@ -0,0 +1,207 @@
+package elasticsearch_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/boes13/elasticsearch"
+)
+
+func TestAliasActions(t *testing.T) {
+	helper := Test{}
+	isWriteIndex := true
+	actions := elasticsearch.NewAliasActions().
+		Remove("logs-1", "logs").
+		Add("logs-2", "logs", elasticsearch.AliasDefinition{IsWriteIndex: &isWriteIndex}).
+		Add("logs-2", `tenant"1`, elasticsearch.AliasDefinition{Filter: json.RawMessage(`{"term": {"tenant": "1"}}`), Routing: "1"}).
+		RemoveIndex("logs")
+
+	body, err := json.Marshal(actions)
+	helper.OK(t, err)
+	helper.Equals(t, `{"actions":[`+
+		`{"remove":{"index":"logs-1","alias":"logs"}},`+
+		`{"add":{"index":"logs-2","alias":"logs","is_write_index":true}},`+
+		`{"add":{"index":"logs-2","alias":"tenant\"1","filter":{"term":{"tenant":"1"}},"routing":"1"}},`+
+		`{"remove_index":{"index":"logs"}}]}`, string(body))
+}
+
+func TestGetAlias(t *testing.T) {
+	helper := Test{}
+	ctx := context.Background()
+
+	server := NewRecorder(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/_alias/logs*":
+			w.Write([]byte(`{
+				"logs-3": {"aliases": {"logs": {"is_write_index": true}}},
+				"logs-1": {"aliases": {"logs": {"is_write_index": false}, "logs-archive": {}}},
+				"logs-2": {"aliases": {"logs": {"is_write_index": false}, "logs-recent": {"filter": {"range": {"date": {"gte": "now-1d"}}}, "index_routing": "1"}}}
+			}`))
+		case "/_alias/logs":
+			w.Write([]byte(`{"logs-2": {"aliases": {"logs": {}}}, "logs-1": {"aliases": {"logs": {}}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": "alias [missing] missing", "status": 404}`))
+		}
+	})
+	defer server.Close()
+	client := server.Client()
+
+	aliases, err := client.GetAlias(ctx, "logs*")
+	helper.OK(t, err)
+	helper.Equals(t, []elasticsearch.AliasInfo{
+		{Name: "logs", Indices: []string{"logs-1", "logs-2", "logs-3"}, WriteIndex: "logs-3"},
+		{Name: "logs-archive", Indices: []string{"logs-1"}, WriteIndex: "logs-1"},
+		{Name: "logs-recent", Indices: []string{"logs-2"}, WriteIndex: "logs-2"},
+	}, aliases)
+
+	indices, err := client.GetIndicesFromAlias("logs")
+	helper.OK(t, err)
+	helper.Equals(t, []string{"logs-1", "logs-2"}, indices)
+
+	_, err = client.GetIndicesFromAlias("missing")
+	helper.Assert(t, errors.Is(err, elasticsearch.ErrNotFound), "A missing alias should return ErrNotFound")
+	_, err = client.GetAlias(ctx, "missing")
+	helper.Assert(t, errors.Is(err, elasticsearch.ErrNotFound), "A missing alias should return ErrNotFound")
+
+	helper.Equals(t, []string{"GET /_alias/logs*", "GET /_alias/logs", "GET /_alias/missing", "GET /_alias/missing"}, server.Requests)
+}
+
+func TestUpdateAndGetAliases(t *testing.T) {
+	helper := Test{}
+	ctx := context.Background()
+
+	server := NewRecorder(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /_aliases":
+			w.Write([]byte(`{"acknowledged": true}`))
+		case "GET /_alias", "GET /_alias/logs,tenant-1":
+			w.Write([]byte(`{"logs-2": {"aliases": {"logs": {"is_write_index": true}, "tenant-1": {"filter": {"term": {"tenant": "1"}}, "routing": "1"}}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": "alias [missing] missing", "status": 404}`))
+		}
+	})
+	defer server.Close()
+	client := server.Client()
+
+	//Update
+	response, err := client.UpdateAliases(ctx, elasticsearch.NewAliasActions().
+		Remove("logs-1", "logs").
+		Add("logs-2", "tenant-1", elasticsearch.AliasDefinition{Filter: json.RawMessage(`{"term":{"tenant":"1"}}`), Routing: "1"}))
+	helper.OK(t, err)
+	helper.Assert(t, response.Acknowledged, "The aliases have not been updated")
+
+	//Get
+	isWriteIndex := true
+	expected := elasticsearch.Aliases{"logs-2": {
+		"logs":     {IsWriteIndex: &isWriteIndex},
+		"tenant-1": {Filter: json.RawMessage(`{"term": {"tenant": "1"}}`), Routing: "1"},
+	}}
+	aliases, err := client.GetAliases(ctx)
+	helper.OK(t, err)
+	helper.Equals(t, expected, aliases)
+	aliases, err = client.GetAliases(ctx, "logs", "tenant-1")
+	helper.OK(t, err)
+	helper.Equals(t, expected, aliases)
+	_, err = client.GetAliases(ctx, "missing")
+	helper.Assert(t, errors.Is(err, elasticsearch.ErrNotFound), "A missing alias should return ErrNotFound")
+
+	helper.Equals(t, []string{"POST /_aliases", "GET /_alias", "GET /_alias/logs,tenant-1", "GET /_alias/missing"}, server.Requests)
+	helper.Equals(t, `{"actions":[`+
+		`{"remove":{"index":"logs-1","alias":"logs"}},`+
+		`{"add":{"index":"logs-2","alias":"tenant-1","filter":{"term":{"tenant":"1"}},"routing":"1"}}]}`, server.Bodies[0])
+}
+
+func TestAliasExists(t *testing.T) {
+	helper := Test{}
+	ctx := context.Background()
+
+	server := NewRecorder(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/_alias/logs,tenant-1":
+			w.WriteHeader(http.StatusOK)
+		case "/_alias/unavailable":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+	client := server.Client()
+
+	exists, err := client.AliasExists(ctx, "logs", "tenant-1")
+	helper.OK(t, err)
+	helper.Assert(t, exists, "The aliases should exist")
+
+	exists, err = client.AliasExists(ctx, "missing")
+	helper.OK(t, err)
+	helper.Assert(t, !exists, "The alias should not exist")
+
+	_, err = client.AliasExists(ctx, "unavailable")
+	var requestErr *elasticsearch.RequestError
+	helper.Assert(t, errors.As(err, &requestErr), "An unavailable search engine should return a RequestError")
+	helper.Equals(t, http.StatusServiceUnavailable, requestErr.StatusCode)
+
+	_, err = client.AliasExists(ctx)
+	helper.Equals(t, elasticsearch.ErrMissingName, err)
+
+	helper.Equals(t, []string{"HEAD /_alias/logs,tenant-1", "HEAD /_alias/missing", "HEAD /_alias/unavailable"}, server.Requests)
+}
+
+func TestResolveIndex(t *testing.T) {
+	helper := Test{}
+	ctx := context.Background()
+
+	//Answers in no particular order
+	server := NewRecorder(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/_resolve/index/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": {"type": "index_not_found_exception"}, "status": 404}`))
+			return
+		}
+		w.Write([]byte(`{
+			"indices": [
+				{"name": "logs-2", "aliases": ["logs", "archive"], "attributes": ["open"]},
+				{"name": ".ds-metrics-000001", "attributes": ["hidden", "open"], "data_stream": "metrics"},
+				{"name": "logs-1", "aliases": ["logs"], "attributes": ["closed"]}
+			],
+			"aliases": [
+				{"name": "logs", "indices": ["logs-2", "logs-1"]},
+				{"name": "archive", "indices": ["logs-2"]}
+			],
+			"data_streams": [
+				{"name": "traces", "backing_indices": [".ds-traces-000001"], "timestamp_field": "@timestamp"},
+				{"name": "metrics", "backing_indices": [".ds-metrics-000001"], "timestamp_field": "@timestamp"}
+			]
+		}`))
+	})
+	defer server.Close()
+	client := server.Client()
+
+	resolved, err := client.ResolveIndex(ctx, "logs-*", "metrics", "traces")
+	helper.OK(t, err)
+	helper.Equals(t, []elasticsearch.ResolvedIndex{
+		{Name: ".ds-metrics-000001", Attributes: []string{"hidden", "open"}, DataStream: "metrics"},
+		{Name: "logs-1", Aliases: []string{"logs"}, Attributes: []string{"closed"}},
+		{Name: "logs-2", Aliases: []string{"archive", "logs"}, Attributes: []string{"open"}},
+	}, resolved.Indices)
+	helper.Equals(t, []elasticsearch.ResolvedAlias{
+		{Name: "archive", Indices: []string{"logs-2"}},
+		{Name: "logs", Indices: []string{"logs-1", "logs-2"}},
+	}, resolved.Aliases)
+	helper.Equals(t, "metrics", resolved.DataStreams[0].Name)
+	helper.Equals(t, "traces", resolved.DataStreams[1].Name)
+
+	_, err = client.ResolveIndex(ctx, "missing")
+	helper.Assert(t, errors.Is(err, elasticsearch.ErrNotFound), "A missing index should return ErrNotFound")
+
+	//Nothing is sent without a pattern
+	_, err = client.ResolveIndex(ctx)
+	helper.Equals(t, elasticsearch.ErrMissingIndex, err)
+
+	helper.Equals(t, []string{"GET /_resolve/index/logs-*,metrics,traces", "GET /_resolve/index/missing"}, server.Requests)
+}
@@ -0,0 +1,105 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+)
+
+// AnalyzeRequest represents the analysis of a text, either with the analyzer of a field, a named analyzer
+// or a custom chain of char filters, tokenizer and token filters.
+// Tokenizer and filters are names or inline definitions.
+type AnalyzeRequest struct {
+	Analyzer   string        `json:"analyzer,omitempty"`
+	Tokenizer  interface{}   `json:"tokenizer,omitempty"`
+	Filter     []interface{} `json:"filter,omitempty"`
+	CharFilter []interface{} `json:"char_filter,omitempty"`
+	Normalizer string        `json:"normalizer,omitempty"`
+	Field      string        `json:"field,omitempty"`
+	Text       []string      `json:"text"`
+	Explain    bool          `json:"explain,omitempty"`
+	Attributes []string      `json:"attributes,omitempty"` // token attributes to return with Explain
+}
+
+// AnalyzeToken represents a token produced by an analysis
+type AnalyzeToken struct {
+	Token          string                 `json:"token"`
+	StartOffset    int                    `json:"start_offset"`
+	EndOffset      int                    `json:"end_offset"`
+	Type           string                 `json:"type"`
+	Position       int                    `json:"position"`
+	PositionLength int                    `json:"positionLength,omitempty"`
+	Bytes          string                 `json:"bytes,omitempty"`
+	Keyword        bool                   `json:"keyword,omitempty"`
+	Attributes     map[string]interface{} `json:"-"`
+}
+
+// UnmarshalJSON decodes the token, keeping the extra attributes returned with explain
+func (t *AnalyzeToken) UnmarshalJSON(data []byte) error {
+	type analyzeToken AnalyzeToken
+	err := json.Unmarshal(data, (*analyzeToken)(t))
+	if err != nil {
+		return err
+	}
+
+	attributes := map[string]interface{}{}
+	err = json.Unmarshal(data, &attributes)
+	if err != nil {
+		return err
+	}
+	for _, name := range []string{"token", "start_offset", "end_offset", "type", "position", "positionLength", "bytes", "keyword"} {
+		delete(attributes, name)
+	}
+	if len(attributes) > 0 {
+		t.Attributes = attributes
+	}
+	return nil
+}
+
+// AnalyzeStep represents the tokens produced by one step of the analysis chain
+type AnalyzeStep struct {
+	Name   string         `json:"name"`
+	Tokens []AnalyzeToken `json:"tokens"`
+}
+
+// AnalyzeDetail represents the output of each step of the analysis chain, returned with explain
+type AnalyzeDetail struct {
+	CustomAnalyzer bool `json:"custom_analyzer"`
+	CharFilters    []struct {
+		Name         string   `json:"name"`
+		FilteredText []string `json:"filtered_text"`
+	} `json:"charfilters,omitempty"`
+	Tokenizer    *AnalyzeStep  `json:"tokenizer,omitempty"`
+	TokenFilters []AnalyzeStep `json:"tokenfilters,omitempty"`
+	Analyzer     *AnalyzeStep  `json:"analyzer,omitempty"`
+}
+
+// AnalyzeResult represents the result of an analysis. Detail is only set with explain.
+type AnalyzeResult struct {
+	Tokens []AnalyzeToken `json:"tokens"`
+	Detail *AnalyzeDetail `json:"detail,omitempty"`
+}
+
+func (c *client) Analyze(ctx context.Context, indexName string, req AnalyzeRequest) (*AnalyzeResult, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return &AnalyzeResult{}, err
+	}
+
+	url := c.Host.String() + "/_analyze"
+	if indexName != "" {
+		url = c.Host.String() + "/" + indexName + "/_analyze"
+	}
+	response, err := sendHTTPRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body), c.Timeout)
+	if err != nil {
+		return &AnalyzeResult{}, err
+	}
+
+	esResp := &AnalyzeResult{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &AnalyzeResult{}, err
+	}
+
+	return esResp, nil
+}
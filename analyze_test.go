@@ -0,0 +1,43 @@
+package elasticsearch_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/boes13/elasticsearch"
+)
+
+func TestAnalyze(t *testing.T) {
+	helper := Test{}
+
+	server := NewRecorder(Reply(http.StatusOK, `{"detail": {
+		"custom_analyzer": true,
+		"tokenizer": {"name": "standard", "tokens": [{"token": "Jeans", "start_offset": 0, "end_offset": 5, "type": "<ALPHANUM>", "position": 0, "termFrequency": 1}]},
+		"tokenfilters": [{"name": "lowercase", "tokens": [{"token": "jeans", "start_offset": 0, "end_offset": 5, "type": "<ALPHANUM>", "position": 0, "keyword": false}]}]
+	}}`))
+	defer server.Close()
+
+	client := server.Client()
+	result, err := client.Analyze(context.Background(), "products", elasticsearch.AnalyzeRequest{
+		Tokenizer: "standard",
+		Filter:    []interface{}{"lowercase"},
+		Text:      []string{"Jeans"},
+		Explain:   true,
+	})
+	helper.OK(t, err)
+
+	var request elasticsearch.AnalyzeRequest
+	helper.Equals(t, []string{"POST /products/_analyze"}, server.Requests)
+	helper.OK(t, json.Unmarshal([]byte(server.Bodies[0]), &request))
+	helper.Equals(t, []interface{}{"lowercase"}, request.Filter)
+	helper.Assert(t, request.Explain, "The explain flag has not been sent")
+
+	helper.Equals(t, "standard", result.Detail.Tokenizer.Name)
+	helper.Equals(t, map[string]interface{}{"termFrequency": float64(1)}, result.Detail.Tokenizer.Tokens[0].Attributes)
+	token := result.Detail.TokenFilters[0].Tokens[0]
+	helper.Equals(t, "jeans", token.Token)
+	helper.Equals(t, 5, token.EndOffset)
+	helper.Assert(t, token.Attributes == nil, "The known fields should not be kept as attributes")
+}
@@ -0,0 +1,88 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+// Conflict handling of reindex, update by query and delete by query operations
+const (
+	ConflictsAbort   = "abort"
+	ConflictsProceed = "proceed"
+)
+
+// DeleteByQueryRequest represents a delete by query operation.
+// The query is required, use a match_all query to delete every document.
+type DeleteByQueryRequest struct {
+	Query     json.RawMessage `json:"query,omitempty"`
+	Conflicts string          `json:"conflicts,omitempty"`
+	MaxDocs   int64           `json:"max_docs,omitempty"`
+
+	BulkByScrollOptions `json:"-"`
+}
+
+// UpdateByQueryRequest represents an update by query operation.
+// Without a query every document of the indices is updated.
+type UpdateByQueryRequest struct {
+	Query     json.RawMessage `json:"query,omitempty"`
+	Script    *Script         `json:"script,omitempty"`
+	Conflicts string          `json:"conflicts,omitempty"`
+	MaxDocs   int64           `json:"max_docs,omitempty"`
+
+	BulkByScrollOptions `json:"-"`
+}
+
+func (c *client) DeleteByQuery(ctx context.Context, indices []string, req DeleteByQueryRequest, opts ...RequestOption) (*BulkByScrollResponse, error) {
+	if len(indices) == 0 {
+		return &BulkByScrollResponse{}, ErrMissingIndex
+	}
+	if len(req.Query) == 0 {
+		return &BulkByScrollResponse{}, ErrMissingQuery
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return &BulkByScrollResponse{}, err
+	}
+
+	url := buildURL(c.Host.String()+"/"+strings.Join(indices, ",")+"/_delete_by_query", bulkByScrollParams(req.BulkByScrollOptions, opts))
+	response, err := sendHTTPRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body), c.Timeout)
+	if err != nil {
+		return &BulkByScrollResponse{}, err
+	}
+
+	esResp := &BulkByScrollResponse{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &BulkByScrollResponse{}, err
+	}
+
+	return esResp, nil
+}
+
+func (c *client) UpdateByQuery(ctx context.Context, indices []string, req UpdateByQueryRequest, opts ...RequestOption) (*BulkByScrollResponse, error) {
+	if len(indices) == 0 {
+		return &BulkByScrollResponse{}, ErrMissingIndex
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return &BulkByScrollResponse{}, err
+	}
+
+	url := buildURL(c.Host.String()+"/"+strings.Join(indices, ",")+"/_update_by_query", bulkByScrollParams(req.BulkByScrollOptions, opts))
+	response, err := sendHTTPRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body), c.Timeout)
+	if err != nil {
+		return &BulkByScrollResponse{}, err
+	}
+
+	esResp := &BulkByScrollResponse{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &BulkByScrollResponse{}, err
+	}
+
+	return esResp, nil
+}
@@ -0,0 +1,63 @@
+package elasticsearch_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/boes13/elasticsearch"
+)
+
+func TestByQuery(t *testing.T) {
+	helper := Test{}
+	ctx := context.Background()
+
+	server := NewRecorder(Reply(http.StatusOK, `{"took": 12, "timed_out": false, "total": 3, "deleted": 2, "updated": 1, "batches": 1,
+		"version_conflicts": 1, "noops": 0, "failures": []}`))
+	defer server.Close()
+	client := server.Client()
+
+	deleteRequest := elasticsearch.DeleteByQueryRequest{
+		Query:     json.RawMessage(`{"term": {"Name": "Jeans"}}`),
+		Conflicts: elasticsearch.ConflictsProceed,
+	}
+	deleteRequest.Slices = "auto"
+	deleteRequest.RequestsPerSecond = 50
+	deleteResponse, err := client.DeleteByQuery(ctx, []string{"products", "archive"}, deleteRequest, elasticsearch.WithRefresh(elasticsearch.RefreshTrue))
+	helper.OK(t, err)
+	helper.Equals(t, int64(2), deleteResponse.Deleted)
+	helper.Equals(t, int64(1), deleteResponse.VersionConflicts)
+	helper.Equals(t, uint64(12), deleteResponse.Took)
+
+	updateRequest := elasticsearch.UpdateByQueryRequest{
+		Script: &elasticsearch.Script{Source: "ctx._source.Stock = 0"},
+	}
+	updateResponse, err := client.UpdateByQuery(ctx, []string{"products"}, updateRequest, elasticsearch.WithWaitForCompletion(false))
+	helper.OK(t, err)
+	helper.Equals(t, int64(1), updateResponse.Updated)
+
+	//Nothing is sent without an index or, for a delete, without a query
+	_, err = client.DeleteByQuery(ctx, nil, deleteRequest)
+	helper.Equals(t, elasticsearch.ErrMissingIndex, err)
+	_, err = client.DeleteByQuery(ctx, []string{"products"}, elasticsearch.DeleteByQueryRequest{})
+	helper.Equals(t, elasticsearch.ErrMissingQuery, err)
+	_, err = client.UpdateByQuery(ctx, []string{}, updateRequest)
+	helper.Equals(t, elasticsearch.ErrMissingIndex, err)
+
+	helper.Equals(t, []string{
+		"POST /products,archive/_delete_by_query?refresh=true&requests_per_second=50&slices=auto",
+		"POST /products/_update_by_query?wait_for_completion=false",
+	}, server.Requests)
+
+	var deleteBody, updateBody map[string]interface{}
+	helper.OK(t, json.Unmarshal([]byte(server.Bodies[0]), &deleteBody))
+	helper.Equals(t, map[string]interface{}{
+		"query":     map[string]interface{}{"term": map[string]interface{}{"Name": "Jeans"}},
+		"conflicts": "proceed",
+	}, deleteBody)
+	helper.OK(t, json.Unmarshal([]byte(server.Bodies[1]), &updateBody))
+	helper.Equals(t, map[string]interface{}{
+		"script": map[string]interface{}{"source": "ctx._source.Stock = 0"},
+	}, updateBody)
+}
@@ -2,14 +2,15 @@ package elasticsearch
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"errors"
 	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"net/url"
-	"strings"
+	"sort"
+	"sync"
 	"time"
 )
 
@@ -18,6 +19,10 @@ type Client interface {
 	// SetHttpTimeout sets timeout to use in http request
 	SetHttpTimeout(duration time.Duration)
 
+	// Info returns the name, cluster and version of the search engine
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/rest-api-root.html
+	Info(ctx context.Context) (*Status, error)
+
 	// CreateIndex instantiates an index
 	// https://www.elasticsearch.org/guide/en/elasticsearch/reference/current/indices-create-index.html
 	CreateIndex(indexName, mapping string) (*Response, error)
@@ -26,6 +31,39 @@ type Client interface {
 	// https://www.elasticsearch.org/guide/en/elasticsearch/reference/current/indices-delete-index.html
 	DeleteIndex(indexName string) (*Response, error)
 
+	// OpenIndex opens closed indices, making them available for read and write operations again
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-open-close.html
+	OpenIndex(ctx context.Context, indices []string, opts ...RequestOption) (*IndexResponse, error)
+
+	// CloseIndex closes indices, blocking read and write operations while keeping their data
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-close.html
+	CloseIndex(ctx context.Context, indices []string, opts ...RequestOption) (*CloseIndexResponse, error)
+
+	// FreezeIndex makes an index read-only and lowers its memory footprint, until Elasticsearch 8
+	// https://www.elastic.co/guide/en/elasticsearch/reference/7.17/freeze-index-api.html
+	FreezeIndex(ctx context.Context, indexName string, opts ...RequestOption) (*IndexResponse, error)
+
+	// UnfreezeIndex makes a frozen index writable again
+	// https://www.elastic.co/guide/en/elasticsearch/reference/7.17/unfreeze-index-api.html
+	UnfreezeIndex(ctx context.Context, indexName string, opts ...RequestOption) (*IndexResponse, error)
+
+	// ShrinkIndex copies a read-only index into a new index with fewer primary shards
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-shrink-index.html
+	ShrinkIndex(ctx context.Context, source, target string, req ResizeRequest, opts ...RequestOption) (*IndexResponse, error)
+
+	// SplitIndex copies a read-only index into a new index with more primary shards
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-split-index.html
+	SplitIndex(ctx context.Context, source, target string, req ResizeRequest, opts ...RequestOption) (*IndexResponse, error)
+
+	// CloneIndex copies a read-only index into a new index
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-clone-index.html
+	CloneIndex(ctx context.Context, source, target string, req ResizeRequest, opts ...RequestOption) (*IndexResponse, error)
+
+	// Rollover points the alias to a new index when one of the conditions is met on its current index.
+	// Once rolled over, the response also holds the indices the alias points to, as GetIndicesFromAlias does.
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-rollover-index.html
+	Rollover(ctx context.Context, alias string, req RolloverRequest, opts ...RequestOption) (*RolloverResponse, error)
+
 	// UpdateIndexSetting changes specific index level settings in real time
 	// https://www.elasticsearch.org/guide/en/elasticsearch/reference/current/indices-update-settings.html
 	UpdateIndexSetting(indexName, mapping string) (*Response, error)
@@ -34,6 +72,107 @@ type Client interface {
 	// https://www.elasticsearch.org/guide/en/elasticsearch/reference/current/indices-get-settings.html
 	IndexSettings(indexName string) (Settings, error)
 
+	// PutTemplate creates or updates a legacy index template
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-templates-v1.html
+	PutTemplate(ctx context.Context, name string, template IndexTemplate) (*Response, error)
+
+	// GetTemplates returns the legacy index templates by name, wildcards allowed. All the templates are
+	// returned when no name is given, ErrNotFound when none matches.
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-get-template-v1.html
+	GetTemplates(ctx context.Context, names ...string) (map[string]IndexTemplate, error)
+
+	// DeleteTemplate deletes a legacy index template
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-delete-template-v1.html
+	DeleteTemplate(ctx context.Context, name string) (*Response, error)
+
+	// PutIndexTemplate creates or updates a composable index template
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-put-template.html
+	PutIndexTemplate(ctx context.Context, name string, template ComposableIndexTemplate) (*Response, error)
+
+	// GetIndexTemplates returns the composable index templates by name, wildcards allowed. All the templates are
+	// returned when no name is given, ErrNotFound when none matches.
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-get-template.html
+	GetIndexTemplates(ctx context.Context, names ...string) (map[string]ComposableIndexTemplate, error)
+
+	// DeleteIndexTemplate deletes a composable index template
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-delete-template.html
+	DeleteIndexTemplate(ctx context.Context, name string) (*Response, error)
+
+	// PutComponentTemplate creates or updates a component template
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-component-template.html
+	PutComponentTemplate(ctx context.Context, name string, template ComponentTemplate) (*Response, error)
+
+	// GetComponentTemplates returns the component templates by name, wildcards allowed. All the templates are
+	// returned when no name is given, ErrNotFound when none matches.
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/getting-component-templates.html
+	GetComponentTemplates(ctx context.Context, names ...string) (map[string]ComponentTemplate, error)
+
+	// DeleteComponentTemplate deletes a component template
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-delete-component-template.html
+	DeleteComponentTemplate(ctx context.Context, name string) (*Response, error)
+
+	// SimulateIndex returns the settings, mappings and aliases an index would get from the composable
+	// index templates if it was created now
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-simulate-index.html
+	SimulateIndex(ctx context.Context, indexName string) (*SimulatedIndex, error)
+
+	// PutLifecyclePolicy creates or updates a lifecycle policy. On OpenSearch the phases are stored as
+	// Index State Management states; updating a policy then requires WithIfSeqNo and WithIfPrimaryTerm.
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/ilm-put-lifecycle.html
+	PutLifecyclePolicy(ctx context.Context, name string, policy LifecyclePolicy, opts ...RequestOption) (*Response, error)
+
+	// GetLifecyclePolicy returns a lifecycle policy, ErrNotFound when it does not exist and ErrMissingName without name
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/ilm-get-lifecycle.html
+	GetLifecyclePolicy(ctx context.Context, name string) (*LifecyclePolicyInfo, error)
+
+	// DeleteLifecyclePolicy deletes a lifecycle policy, ErrNotFound when it does not exist
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/ilm-delete-lifecycle.html
+	DeleteLifecyclePolicy(ctx context.Context, name string) (*Response, error)
+
+	// ExplainLifecycle returns the current lifecycle phase, action and step of the indices, at least one is required
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/ilm-explain-lifecycle.html
+	ExplainLifecycle(ctx context.Context, indices ...string) (map[string]LifecycleExplain, error)
+
+	// StartLifecycle starts the lifecycle management of the cluster, ErrUnsupported on OpenSearch
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/ilm-start.html
+	StartLifecycle(ctx context.Context) (*Response, error)
+
+	// StopLifecycle stops the lifecycle management of the cluster, ErrUnsupported on OpenSearch
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/ilm-stop.html
+	StopLifecycle(ctx context.Context) (*Response, error)
+
+	// RetryLifecycle retries the failed lifecycle step of the indices, at least one is required
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/ilm-retry-policy.html
+	RetryLifecycle(ctx context.Context, indices ...string) (*Response, error)
+
+	// Refresh makes all the operations performed on the indices since the last refresh available for search.
+	// All the indices are refreshed when none is given.
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-refresh.html
+	Refresh(ctx context.Context, indices ...string) (*ShardsResponse, error)
+
+	// Flush writes the data of the indices to disk and clears their transaction log.
+	// All the indices are flushed when none is given.
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-flush.html
+	Flush(ctx context.Context, indices ...string) (*ShardsResponse, error)
+
+	// Analyze runs an analysis chain on a text and returns the produced tokens.
+	// indexName is only needed to use the analyzers defined in its mapping.
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-analyze.html
+	Analyze(ctx context.Context, indexName string, req AnalyzeRequest) (*AnalyzeResult, error)
+
+	// ForceMerge merges the segments of the indices, down to maxNumSegments when it is positive.
+	// Use WithWaitForCompletion(false) to run long merges as a task.
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-forcemerge.html
+	ForceMerge(ctx context.Context, indices []string, maxNumSegments int, onlyExpungeDeletes bool, opts ...RequestOption) (*ForceMergeResponse, error)
+
+	// ClearCache clears the caches of the indices, all the indices when none is given
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-clearcache.html
+	ClearCache(ctx context.Context, indices []string, options ClearCacheOptions) (*ShardsResponse, error)
+
+	// Segments returns the Lucene segments of each shard of the indices
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-segments.html
+	Segments(ctx context.Context, indices ...string) (*SegmentsResult, error)
+
 	// IndexExists allows to check if the index exists or not.
 	// https://www.elasticsearch.org/guide/en/elasticsearch/reference/current/indices-exists.html
 	IndexExists(indexName string) (bool, error)
@@ -41,52 +180,190 @@ type Client interface {
 	// Status allows to get a comprehensive status information
 	Status(indices string) (*Settings, error)
 
-	// InsertDocument adds or updates a typed JSON document in a specific index, making it searchable
+	// InsertDocument adds or updates a typed JSON document in a specific index, making it searchable.
+	// Use WithRefresh to make the change visible to search.
+	// WithIfSeqNo and WithIfPrimaryTerm make the write conditional; a failed condition returns ErrVersionConflict.
 	// https://www.elasticsearch.org/guide/en/elasticsearch/reference/current/docs-index_.html
-	InsertDocument(indexName, documentType, identifier string, data []byte) (*InsertDocument, error)
+	InsertDocument(indexName, documentType, identifier string, data []byte, opts ...RequestOption) (*InsertDocument, error)
 
-	// Document gets a typed JSON document from the index based on its id
+	// Document gets a typed JSON document from the index based on its id.
+	// Options such as WithSourceIncludes or WithStoredFields avoid fetching the whole _source.
+	// A missing document is not an error, Found is false instead.
 	// https://www.elasticsearch.org/guide/en/elasticsearch/reference/current/docs-get.html
-	Document(indexName, documentType, identifier string) (*Document, error)
-
-	// DeleteDocument deletes a typed JSON document from a specific index based on its id
+	Document(indexName, documentType, identifier string, opts ...RequestOption) (*Document, error)
+
+	// DocumentExists allows to check if a document exists or not.
+	// Any answer other than found or not found, e.g. a 503, returns a *RequestError.
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/docs-get.html
+	DocumentExists(ctx context.Context, indexName, identifier string, opts ...RequestOption) (bool, error)
+
+	// DocumentSource gets only the _source of a JSON document. ErrNotFound is returned if the document does not exist.
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/docs-get.html
+	DocumentSource(ctx context.Context, indexName, identifier string, opts ...RequestOption) (json.RawMessage, error)
+
+	// MultiGet gets several JSON documents in a single call, in the order of the items.
+	// Use MultiGetTyped to decode their _source.
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/docs-multi-get.html
+	MultiGet(ctx context.Context, items []MultiGetItem, opts ...RequestOption) (*MultiGetResult, error)
+
+	// DeleteDocument deletes a typed JSON document from a specific index based on its id.
+	// Use WithRefresh to make the change visible to search.
+	// WithIfSeqNo and WithIfPrimaryTerm make the delete conditional; a failed condition returns ErrVersionConflict.
+	// A missing document is not an error, Found is false instead.
 	// https://www.elasticsearch.org/guide/en/elasticsearch/reference/current/docs-delete.html
-	DeleteDocument(indexName, documentType, identifier string) (*Document, error)
+	DeleteDocument(indexName, documentType, identifier string, opts ...RequestOption) (*Document, error)
+
+	// CreateDocument adds a JSON document in a specific index only if no document exists with the same id,
+	// otherwise ErrVersionConflict is returned
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/docs-index_.html
+	CreateDocument(ctx context.Context, indexName, identifier string, data []byte, opts ...RequestOption) (*InsertDocument, error)
+
+	// IndexDocument adds a JSON document in a specific index with an id generated by the search engine.
+	// The generated id is returned in the response.
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/docs-index_.html
+	IndexDocument(ctx context.Context, indexName string, data []byte, opts ...RequestOption) (*InsertDocument, error)
+
+	// UpdateDocument partially updates a JSON document in a specific index, either by merging a partial
+	// document or by running a script. The result is ResultUpdated, ResultCreated or ResultNoop.
+	// Updating a missing document without an upsert returns an error matching ErrNotFound.
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/docs-update.html
+	UpdateDocument(ctx context.Context, indexName, identifier string, req UpdateRequest, opts ...RequestOption) (*UpdateDocument, error)
+
+	// TermVectors returns information and statistics about the terms in the fields of a stored document,
+	// or of an artificial document when req.ID is empty
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/docs-termvectors.html
+	TermVectors(ctx context.Context, indexName string, req TermVectorsRequest, opts ...RequestOption) (*TermVectorsResult, error)
+
+	// MultiTermVectors returns the term vectors of several documents in a single call, in the order of the requests
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/docs-multi-termvectors.html
+	MultiTermVectors(ctx context.Context, indexName string, reqs []TermVectorsRequest, opts ...RequestOption) (*MultiTermVectorsResult, error)
 
 	// Bulk makes it possible to perform many index/delete operations in a single API call.
-	// This can greatly increase the indexing speed.
+	// This can greatly increase the indexing speed. Use WithRefresh to make the changes visible to search.
 	// https://www.elasticsearch.org/guide/en/elasticsearch/reference/current/docs-bulk.html
-	Bulk(data []byte) (*Bulk, error)
+	Bulk(data []byte, opts ...RequestOption) (*Bulk, error)
 
-	// Search allows to execute a search query and get back search hits that match the query
+	// Search allows to execute a search query and get back search hits that match the query.
+	// Use WithRouting and WithPreference to target specific shards.
 	// http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/docs-delete.html
-	Search(indexName, documentType, data string, explain bool) (*SearchResult, error)
+	Search(indexName, documentType, data string, explain bool, opts ...RequestOption) (*SearchResult, error)
+
+	// SearchWithRequest allows to execute a typed search request on one or several indices
+	// and get back search hits that match the query
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/search-search.html
+	SearchWithRequest(ctx context.Context, req SearchRequest, opts ...RequestOption) (*SearchResult, error)
 
 	// MSearch allows to execute a multi-search and get back result
 	// https://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-multi-search.html
 	MSearch(queries []MSearchQuery) (*MSearchResult, error)
 
+	// MultiSearch allows to execute several typed search requests in a single call.
+	// Each result carries its own status and error, in the order of the requests.
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/search-multi-search.html
+	MultiSearch(ctx context.Context, requests []SearchRequest, opts ...RequestOption) (*MultiSearchResult, error)
+
+	// Count returns the number of documents of the indices matching the query, all the documents when query is nil
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/search-count.html
+	Count(ctx context.Context, indices []string, query json.RawMessage, opts ...RequestOption) (*CountResult, error)
+
+	// CatCount returns the number of documents of the indices through the cat API, summing the returned rows.
+	// It can't filter documents and Count never falls back on it by itself: call it instead of Count
+	// for unfiltered counts, e.g. when the search API is overloaded.
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/cat-count.html
+	CatCount(ctx context.Context, indices ...string) (int64, error)
+
+	// Explain computes a score explanation for a query and a specific document
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/search-explain.html
+	Explain(ctx context.Context, indexName, identifier string, query json.RawMessage, opts ...RequestOption) (*ExplainResult, error)
+
+	// ValidateQuery validates a potentially expensive query without executing it.
+	// explain returns why an invalid query fails, rewrite returns the query actually run by the search engine.
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/search-validate.html
+	ValidateQuery(ctx context.Context, indexName string, query json.RawMessage, explain, rewrite bool) (*ValidateQueryResult, error)
+
+	// FieldCaps returns the capabilities of the fields across the indices, e.g. whether they are searchable
+	// or aggregatable. Wildcards are allowed, all the fields are returned when none is given.
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/search-field-caps.html
+	FieldCaps(ctx context.Context, indices []string, fields ...string) (*FieldCapsResult, error)
+
 	// Suggest allows basic auto-complete functionality.
 	// https://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-suggesters-completion.html
-	Suggest(indexName, data string) ([]byte, error)
+	//
+	// Deprecated: the _suggest endpoint has been removed in Elasticsearch 6, use Suggestions instead.
+	Suggest(indexName, data string, opts ...RequestOption) ([]byte, error)
+
+	// Suggestions runs term, phrase and completion suggesters through the search API
+	// and returns the suggestions by suggester name
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/search-suggesters.html
+	Suggestions(ctx context.Context, indices []string, suggesters map[string]Suggester, opts ...RequestOption) (map[string][]Suggestion, error)
 
-	// GetIndicesFromAlias returns the list of indices the alias points to
+	// GetIndicesFromAlias returns the sorted list of indices the alias points to, ErrNotFound when the alias does not exist
 	GetIndicesFromAlias(alias string) ([]string, error)
 
+	// GetAlias returns the aliases matching the patterns, sorted by name, with their sorted indices and write index.
+	// ErrNotFound is returned when a named alias does not exist.
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-get-alias.html
+	GetAlias(ctx context.Context, patterns ...string) ([]AliasInfo, error)
+
+	// ResolveIndex resolves the patterns into the matching indices, aliases and data streams, sorted by name.
+	// At least one pattern is required, * resolves everything. ErrNotFound is returned when a name without
+	// wildcard does not exist.
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-resolve-index-api.html
+	ResolveIndex(ctx context.Context, patterns ...string) (*ResolvedIndices, error)
+
 	// UpdateAlias updates the indices on which the alias points to.
 	// The change is atomic.
 	UpdateAlias(remove []string, add []string, alias string) (*Response, error)
 
+	// UpdateAliases applies the actions on many aliases atomically, e.g. to move an alias with its filter
+	// and routing from an index to another
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-aliases.html
+	UpdateAliases(ctx context.Context, actions *AliasActions) (*Response, error)
+
+	// GetAliases returns the aliases by index with their filter, routing and write index settings.
+	// Wildcards are allowed, all the aliases are returned when none is given, ErrNotFound when one is missing.
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-get-alias.html
+	GetAliases(ctx context.Context, aliases ...string) (Aliases, error)
+
+	// AliasExists allows to check if the aliases exist or not, at least one alias is required.
+	// An error status other than 404 is returned as a *RequestError.
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-alias-exists.html
+	AliasExists(ctx context.Context, aliases ...string) (bool, error)
+
 	// Search document using scan search type and the scroll API to retrieve large numbers of documents from
 	// Elasticsearch efficiently, without paying the penalty of deep pagination.
 	// https://www.elastic.co/guide/en/elasticsearch/guide/1.x/scan-scroll.html
-	SearchByScanAndScroll(indexName string, documentType string, expireTime time.Duration, body string) (*Scroller, error)
+	SearchByScanAndScroll(indexName string, documentType string, expireTime time.Duration, body string, opts ...RequestOption) (*Scroller, error)
+
+	// Reindex copies documents from one or more source indices to a destination index.
+	// With WithWaitForCompletion(false) the operation runs in the background and the response only holds the task id.
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/docs-reindex.html
+	Reindex(ctx context.Context, req ReindexRequest, opts ...RequestOption) (*BulkByScrollResponse, error)
+
+	// DeleteByQuery deletes every document of the indices matching the query
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/docs-delete-by-query.html
+	DeleteByQuery(ctx context.Context, indices []string, req DeleteByQueryRequest, opts ...RequestOption) (*BulkByScrollResponse, error)
+
+	// UpdateByQuery updates every document of the indices matching the query, usually through a script
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/docs-update-by-query.html
+	UpdateByQuery(ctx context.Context, indices []string, req UpdateByQueryRequest, opts ...RequestOption) (*BulkByScrollResponse, error)
+
+	// GetTask returns the progress of a task, such as an asynchronous reindex
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/tasks.html
+	GetTask(ctx context.Context, taskID string) (*Task, error)
+
+	// CancelTask cancels a running task
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/tasks.html
+	CancelTask(ctx context.Context, taskID string) (*CancelTaskResponse, error)
 }
 
 // A SearchClient describes the client configuration to manage an ElasticSearch index.
 type client struct {
 	Host    url.URL
 	Timeout time.Duration
+
+	mu   sync.Mutex
+	info *Status // cached by isOpenSearch
 }
 
 // NewSearchClient creates and initializes a new ElasticSearch client, implements core api for Indexing and searching.
@@ -207,8 +484,8 @@ func (c *client) Status(indices string) (*Settings, error) {
 	return esResp, nil
 }
 
-func (c *client) InsertDocument(indexName, documentType, identifier string, data []byte) (*InsertDocument, error) {
-	url := c.Host.String() + "/" + indexName + "/" + documentType + "/" + identifier
+func (c *client) InsertDocument(indexName, documentType, identifier string, data []byte, opts ...RequestOption) (*InsertDocument, error) {
+	url := buildURL(c.Host.String()+"/"+indexName+"/"+documentType+"/"+identifier, requestParams(opts))
 	reader := bytes.NewBuffer(data)
 	response, err := sendHTTPRequest("POST", url, reader, c.Timeout)
 	if err != nil {
@@ -224,12 +501,16 @@ func (c *client) InsertDocument(indexName, documentType, identifier string, data
 	return esResp, nil
 }
 
-func (c *client) Document(indexName, documentType, identifier string) (*Document, error) {
-	url := c.Host.String() + "/" + indexName + "/" + documentType + "/" + identifier
-	response, err := sendHTTPRequest("GET", url, nil, c.Timeout)
+func (c *client) Document(indexName, documentType, identifier string, opts ...RequestOption) (*Document, error) {
+	url := buildURL(c.Host.String()+"/"+indexName+"/"+documentType+"/"+identifier, requestParams(opts))
+	// a missing document is reported with found set to false rather than as an error
+	statusCode, response, err := doHTTPRequest(context.Background(), "GET", url, nil, c.Timeout)
 	if err != nil {
 		return &Document{}, err
 	}
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound {
+		return &Document{}, &RequestError{StatusCode: statusCode, Body: string(response)}
+	}
 
 	esResp := &Document{}
 	err = json.Unmarshal(response, esResp)
@@ -240,12 +521,16 @@ func (c *client) Document(indexName, documentType, identifier string) (*Document
 	return esResp, nil
 }
 
-func (c *client) DeleteDocument(indexName, documentType, identifier string) (*Document, error) {
-	url := c.Host.String() + "/" + indexName + "/" + documentType + "/" + identifier
-	response, err := sendHTTPRequest("DELETE", url, nil, c.Timeout)
+func (c *client) DeleteDocument(indexName, documentType, identifier string, opts ...RequestOption) (*Document, error) {
+	url := buildURL(c.Host.String()+"/"+indexName+"/"+documentType+"/"+identifier, requestParams(opts))
+	// a missing document is reported with found set to false rather than as an error
+	statusCode, response, err := doHTTPRequest(context.Background(), "DELETE", url, nil, c.Timeout)
 	if err != nil {
 		return &Document{}, err
 	}
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound {
+		return &Document{}, &RequestError{StatusCode: statusCode, Body: string(response)}
+	}
 
 	esResp := &Document{}
 	err = json.Unmarshal(response, esResp)
@@ -256,8 +541,8 @@ func (c *client) DeleteDocument(indexName, documentType, identifier string) (*Do
 	return esResp, nil
 }
 
-func (c *client) Bulk(data []byte) (*Bulk, error) {
-	url := c.Host.String() + "/_bulk"
+func (c *client) Bulk(data []byte, opts ...RequestOption) (*Bulk, error) {
+	url := buildURL(c.Host.String()+"/_bulk", requestParams(opts))
 	reader := bytes.NewBuffer(data)
 	response, err := sendHTTPRequest("POST", url, reader, c.Timeout)
 	if err != nil {
@@ -274,8 +559,13 @@ func (c *client) Bulk(data []byte) (*Bulk, error) {
 }
 
 func (c *client) GetIndicesFromAlias(alias string) ([]string, error) {
-	url := c.Host.String() + "/*/_alias/" + alias
-	response, err := sendHTTPRequest("GET", url, nil, c.Timeout)
+	return c.indicesFromAlias(context.Background(), alias)
+}
+
+// indicesFromAlias returns the sorted indices the alias points to
+func (c *client) indicesFromAlias(ctx context.Context, alias string) ([]string, error) {
+	url := c.Host.String() + "/_alias/" + alias
+	response, err := sendHTTPRequestWithContext(ctx, "GET", url, nil, c.Timeout)
 	if err != nil {
 		return []string{}, err
 	}
@@ -292,13 +582,17 @@ func (c *client) GetIndicesFromAlias(alias string) ([]string, error) {
 		indices[i] = k
 		i++
 	}
+	sort.Strings(indices)
 	return indices, nil
 }
 
 func (c *client) UpdateAlias(remove []string, add []string, alias string) (*Response, error) {
 	url := c.Host.String() + "/_aliases"
-	body := getAliasQuery(remove, add, alias)
-	reader := bytes.NewBufferString(body)
+	body, err := getAliasQuery(remove, add, alias)
+	if err != nil {
+		return &Response{}, err
+	}
+	reader := bytes.NewBuffer(body)
 
 	response, err := sendHTTPRequest("POST", url, reader, c.Timeout)
 	if err != nil {
@@ -314,30 +608,64 @@ func (c *client) UpdateAlias(remove []string, add []string, alias string) (*Resp
 	return esResp, nil
 }
 
-func getAliasQuery(remove []string, add []string, alias string) string {
-	actions := make([]string, len(remove)+len(add))
-
-	i := 0
+func getAliasQuery(remove []string, add []string, alias string) ([]byte, error) {
+	actions := NewAliasActions()
 	for _, index := range remove {
-		actions[i] = "{ \"remove\": { \"index\": \"" + index + "\", \"alias\": \"" + alias + "\" }}"
-		i++
+		actions.Remove(index, alias)
 	}
 
 	for _, index := range add {
-		actions[i] = "{ \"add\": { \"index\": \"" + index + "\", \"alias\": \"" + alias + "\" }}"
-		i++
+		actions.Add(index, alias, AliasDefinition{})
 	}
 
-	return "{\"actions\": [ " + strings.Join(actions, ",") + " ]}"
+	return json.Marshal(actions)
 }
 
 func sendHTTPRequest(method, url string, body io.Reader, timeout time.Duration) ([]byte, error) {
+	return sendHTTPRequestWithContext(context.Background(), method, url, body, timeout)
+}
+
+// sendHTTPRequestWithContext sends the request bound to ctx and returns the raw response body,
+// or a *RequestError if the search engine answered with a status code of 300 or above
+func sendHTTPRequestWithContext(ctx context.Context, method, url string, body io.Reader, timeout time.Duration) ([]byte, error) {
+	statusCode, response, err := doHTTPRequest(ctx, method, url, body, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, &RequestError{StatusCode: statusCode, Body: string(response)}
+	}
+
+	return response, nil
+}
+
+// sendHeadRequest reports whether the resource at url exists: true on 200, false on 404,
+// and a *RequestError for any other status code
+func sendHeadRequest(ctx context.Context, url string, timeout time.Duration) (bool, error) {
+	statusCode, response, err := doHTTPRequest(ctx, "HEAD", url, nil, timeout)
+	if err != nil {
+		return false, err
+	}
+
+	switch statusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	}
+	return false, &RequestError{StatusCode: statusCode, Body: string(response)}
+}
+
+// doHTTPRequest sends the request bound to ctx and returns the status code along with the raw response body
+func doHTTPRequest(ctx context.Context, method, url string, body io.Reader, timeout time.Duration) (int, []byte, error) {
 	client := &http.Client{}
 	client.Timeout = timeout
 	req, err := http.NewRequest(method, url, body)
 	if err != nil {
-		return nil, err
+		return 0, nil, err
 	}
+	req = req.WithContext(ctx)
 
 	if method == "POST" || method == "PUT" {
 		req.Header.Set("Content-Type", "application/json")
@@ -345,18 +673,22 @@ func sendHTTPRequest(method, url string, body io.Reader, timeout time.Duration)
 
 	newReq, err := client.Do(req)
 	if err != nil {
-		return nil, err
+		return 0, nil, err
 	}
 
 	defer newReq.Body.Close()
 	response, err := ioutil.ReadAll(newReq.Body)
 	if err != nil {
-		return nil, err
+		return 0, nil, err
 	}
 
-	if newReq.StatusCode > http.StatusCreated && newReq.StatusCode < http.StatusNotFound {
-		return nil, errors.New(string(response))
-	}
+	return newReq.StatusCode, response, nil
+}
 
-	return response, nil
+// buildURL appends the encoded parameters, if any, to the given url
+func buildURL(base string, params url.Values) string {
+	if len(params) == 0 {
+		return base
+	}
+	return base + "?" + params.Encode()
 }
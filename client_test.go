@@ -2,7 +2,11 @@ package elasticsearch_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
 	"testing"
 	"time"
 
@@ -77,6 +81,154 @@ func TestIndexManagement(t *testing.T) {
 
 }
 
+func TestTemplates(t *testing.T) {
+	helper := Test{}
+	ctx := context.Background()
+	client := elasticsearch.NewClient(ESScheme, ESHost, ESPort)
+	client.SetHttpTimeout(5 * time.Second)
+
+	//Component template
+	component := elasticsearch.ComponentTemplate{}
+	component.Template.Settings = json.RawMessage(`{"number_of_shards": 2}`)
+	response, err := client.PutComponentTemplate(ctx, "test-settings", component)
+	helper.OK(t, err)
+	helper.Assert(t, response.Acknowledged, "The component template has not been created")
+
+	//Composable template
+	priority := 100
+	template := elasticsearch.ComposableIndexTemplate{
+		IndexPatterns: []string{"test-logs-*"},
+		Priority:      &priority,
+		ComposedOf:    []string{"test-settings"},
+		Template: &elasticsearch.Template{
+			Aliases: map[string]elasticsearch.AliasDefinition{"test-logs": {}},
+		},
+	}
+	response, err = client.PutIndexTemplate(ctx, "test-logs", template)
+	helper.OK(t, err)
+	helper.Assert(t, response.Acknowledged, "The index template has not been created")
+
+	templates, err := client.GetIndexTemplates(ctx, "test-logs")
+	helper.OK(t, err)
+	helper.Equals(t, []string{"test-settings"}, templates["test-logs"].ComposedOf)
+
+	//Simulate
+	simulated, err := client.SimulateIndex(ctx, "test-logs-2020.01.01")
+	helper.OK(t, err)
+	_, ok := simulated.Template.Aliases["test-logs"]
+	helper.Assert(t, ok, "The simulated index has not the alias of the template")
+
+	//Delete
+	_, err = client.DeleteIndexTemplate(ctx, "test-logs")
+	helper.OK(t, err)
+	_, err = client.DeleteComponentTemplate(ctx, "test-settings")
+	helper.OK(t, err)
+
+	_, err = client.GetIndexTemplates(ctx, "test-logs")
+	helper.Assert(t, errors.Is(err, elasticsearch.ErrNotFound), "The index template has not been deleted")
+}
+
+func TestRollover(t *testing.T) {
+	helper := Test{}
+	ctx := context.Background()
+	client := elasticsearch.NewClient(ESScheme, ESHost, ESPort)
+	client.SetHttpTimeout(5 * time.Second)
+	err := putIndex("test-000001", `{"aliases": {"test-write": {"is_write_index": true}}}`)
+	helper.OK(t, err)
+
+	_, err = client.InsertDocument("test-write", "_doc", "1", []byte(`{"Name":"Jeans"}`), elasticsearch.WithRefresh(elasticsearch.RefreshTrue))
+	helper.OK(t, err)
+
+	//Dry run
+	req := elasticsearch.RolloverRequest{Conditions: elasticsearch.RolloverConditions{MaxDocs: 1}, DryRun: true}
+	rollover, err := client.Rollover(ctx, "test-write", req)
+	helper.OK(t, err)
+	helper.Assert(t, !rollover.RolledOver && rollover.Conditions["[max_docs: 1]"], "The dry run should only check the conditions")
+
+	//Rollover
+	req.DryRun = false
+	rollover, err = client.Rollover(ctx, "test-write", req, elasticsearch.WithWaitForActiveShards("1"))
+	helper.OK(t, err)
+	helper.Assert(t, rollover.RolledOver, "The alias has not been rolled over")
+	helper.Equals(t, "test-000002", rollover.NewIndex)
+	helper.Equals(t, []string{"test-000001", "test-000002"}, rollover.AliasIndices)
+
+	indices, err := client.GetIndicesFromAlias("test-write")
+	helper.OK(t, err)
+	helper.Assert(t, len(indices) == 2, "The alias should point to both indices")
+
+	//Close and open
+	closeResponse, err := client.CloseIndex(ctx, []string{"test-000001"})
+	helper.OK(t, err)
+	helper.Assert(t, closeResponse.Indices["test-000001"].Closed, "The index has not been closed")
+
+	openResponse, err := client.OpenIndex(ctx, []string{"test-000001"})
+	helper.OK(t, err)
+	helper.Assert(t, openResponse.Acknowledged, "The index has not been opened")
+
+	//Delete the indices
+	client.DeleteIndex("test-000001")
+	deleteResponse, err := client.DeleteIndex("test-000002")
+	helper.OK(t, err)
+	helper.Assert(t, deleteResponse.Acknowledged, "Index has not been deleted")
+}
+
+// putIndex creates an index with a PUT request, as CreateIndex sends a POST rejected since Elasticsearch 7
+func putIndex(name, body string) error {
+	req, err := http.NewRequest(http.MethodPut, ESScheme+"://"+ESHost+":"+ESPort+"/"+name, bytes.NewBufferString(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	response, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		data, _ := ioutil.ReadAll(response.Body)
+		return &elasticsearch.RequestError{StatusCode: response.StatusCode, Body: string(data)}
+	}
+	return nil
+}
+
+func TestForceMerge(t *testing.T) {
+	helper := Test{}
+	ctx := context.Background()
+	client := elasticsearch.NewClient(ESScheme, ESHost, ESPort)
+	client.SetHttpTimeout(5 * time.Second)
+	client.CreateIndex(IndexName, IndexMapping)
+
+	_, err := client.InsertDocument(IndexName, "_doc", "1", []byte(`{"Name":"Jeans"}`), elasticsearch.WithRefresh(elasticsearch.RefreshTrue))
+	helper.OK(t, err)
+
+	//Segments
+	segments, err := client.Segments(ctx, IndexName)
+	helper.OK(t, err)
+	helper.Assert(t, len(segments.Indices[IndexName].Shards) > 0, "The segments have not been returned")
+
+	//Force merge
+	merge, err := client.ForceMerge(ctx, []string{IndexName}, 1, false)
+	helper.OK(t, err)
+	helper.Assert(t, merge.Shards.Failed == 0, "The force merge has failed")
+
+	merge, err = client.ForceMerge(ctx, []string{IndexName}, 1, false, elasticsearch.WithWaitForCompletion(false))
+	helper.OK(t, err)
+	helper.Assert(t, merge.Task != "", "The force merge has not returned a task id")
+
+	//Clear cache
+	clear, err := client.ClearCache(ctx, []string{IndexName}, elasticsearch.ClearCacheOptions{Query: true, Request: true})
+	helper.OK(t, err)
+	helper.Assert(t, clear.Shards.Failed == 0, "The caches have not been cleared")
+
+	//Delete the index
+	deleteResponse, err := client.DeleteIndex(IndexName)
+	helper.OK(t, err)
+	helper.Assert(t, deleteResponse.Acknowledged, "Index has not been deleted")
+}
+
 func TestCRUD(t *testing.T) {
 	type Product struct {
 		Name string
@@ -128,6 +280,192 @@ func TestCRUD(t *testing.T) {
 	helper.Assert(t, deleteResponse.Acknowledged, "Index has not been deleted")
 }
 
+func TestCreateDocument(t *testing.T) {
+	helper := Test{}
+	ctx := context.Background()
+	client := elasticsearch.NewClient(ESScheme, ESHost, ESPort)
+	client.SetHttpTimeout(5 * time.Second)
+	client.CreateIndex(IndexName, IndexMapping)
+
+	//Create
+	createResponse, err := client.CreateDocument(ctx, IndexName, "1234", []byte(`{"Name":"Jeans"}`))
+	helper.OK(t, err)
+	helper.Assert(t, createResponse.Result == elasticsearch.ResultCreated, "The document has not been created")
+
+	//Create an existing document
+	_, err = client.CreateDocument(ctx, IndexName, "1234", []byte(`{"Name":"Polo"}`))
+	helper.Assert(t, errors.Is(err, elasticsearch.ErrVersionConflict), "The existing document should not be overwritten")
+
+	//Auto-generated id
+	indexResponse, err := client.IndexDocument(ctx, IndexName, []byte(`{"Name":"Shirt"}`))
+	helper.OK(t, err)
+	helper.Assert(t, indexResponse.ID != "", "No id has been generated")
+
+	//Delete the index
+	deleteResponse, err := client.DeleteIndex(IndexName)
+	helper.OK(t, err)
+	helper.Assert(t, deleteResponse.Acknowledged, "Index has not been deleted")
+}
+
+func TestDocumentOptions(t *testing.T) {
+	type Product struct {
+		Name        string
+		Description string
+	}
+
+	helper := Test{}
+	ctx := context.Background()
+	client := elasticsearch.NewClient(ESScheme, ESHost, ESPort)
+	client.SetHttpTimeout(5 * time.Second)
+	client.CreateIndex(IndexName, IndexMapping)
+
+	_, err := client.InsertDocument(IndexName, "_doc", "1234", []byte(`{"Name":"Jeans","Description":"Blue denim"}`))
+	helper.OK(t, err)
+
+	//Source filtering
+	readResponse, err := client.Document(IndexName, "_doc", "1234", elasticsearch.WithSourceIncludes("Name"), elasticsearch.WithRealtime(true))
+	helper.OK(t, err)
+	var p Product
+	err = json.Unmarshal(readResponse.Source, &p)
+	helper.OK(t, err)
+	helper.Equals(t, Product{Name: "Jeans"}, p)
+
+	//Source only
+	source, err := client.DocumentSource(ctx, IndexName, "1234", elasticsearch.WithSourceExcludes("Name"))
+	helper.OK(t, err)
+	p = Product{}
+	err = json.Unmarshal(source, &p)
+	helper.OK(t, err)
+	helper.Equals(t, Product{Description: "Blue denim"}, p)
+
+	_, err = client.DocumentSource(ctx, IndexName, "4321")
+	helper.Assert(t, errors.Is(err, elasticsearch.ErrNotFound), "A missing document should return ErrNotFound")
+
+	//Exists
+	exists, err := client.DocumentExists(ctx, IndexName, "1234")
+	helper.OK(t, err)
+	helper.Assert(t, exists, "The document should exist")
+
+	exists, err = client.DocumentExists(ctx, IndexName, "4321")
+	helper.OK(t, err)
+	helper.Assert(t, !exists, "The document should not exist")
+
+	//Delete the index
+	deleteResponse, err := client.DeleteIndex(IndexName)
+	helper.OK(t, err)
+	helper.Assert(t, deleteResponse.Acknowledged, "Index has not been deleted")
+}
+
+func TestRouting(t *testing.T) {
+	helper := Test{}
+	client := elasticsearch.NewClient(ESScheme, ESHost, ESPort)
+	client.SetHttpTimeout(5 * time.Second)
+	client.CreateIndex(IndexName, IndexMapping)
+	routing := elasticsearch.WithRouting("tenant1")
+
+	//Insert
+	_, err := client.InsertDocument(IndexName, "_doc", "1234", []byte(`{"Name":"Jeans"}`), routing, elasticsearch.WithRefresh(elasticsearch.RefreshTrue))
+	helper.OK(t, err)
+
+	//Read
+	readResponse, err := client.Document(IndexName, "_doc", "1234", routing)
+	helper.OK(t, err)
+	helper.Assert(t, readResponse.Found, "The document has not been found with its routing")
+
+	//Search
+	search, err := client.Search(IndexName, "", `{"query": {"match_all": {}}}`, false, routing, elasticsearch.WithPreference("_local"))
+	helper.OK(t, err)
+	helper.Assert(t, len(search.Hits.Hits) == 1, "The document has not been found with its routing")
+
+	//Delete
+	delResponse, err := client.DeleteDocument(IndexName, "_doc", "1234", routing)
+	helper.OK(t, err)
+	helper.Assert(t, delResponse.Found, "The document has not been deleted with its routing")
+
+	//Delete the index
+	deleteResponse, err := client.DeleteIndex(IndexName)
+	helper.OK(t, err)
+	helper.Assert(t, deleteResponse.Acknowledged, "Index has not been deleted")
+}
+
+func TestMultiGet(t *testing.T) {
+	type Product struct {
+		Name string
+	}
+
+	helper := Test{}
+	ctx := context.Background()
+	client := elasticsearch.NewClient(ESScheme, ESHost, ESPort)
+	client.SetHttpTimeout(5 * time.Second)
+	client.CreateIndex(IndexName, IndexMapping)
+
+	_, err := client.CreateDocument(ctx, IndexName, "1", []byte(`{"Name":"Jeans"}`))
+	helper.OK(t, err)
+	_, err = client.CreateDocument(ctx, IndexName, "2", []byte(`{"Name":"Polo"}`))
+	helper.OK(t, err)
+
+	items := []elasticsearch.MultiGetItem{
+		{Index: IndexName, ID: "2"},
+		{Index: IndexName, ID: "3"},
+		{Index: IndexName, ID: "1", Source: &elasticsearch.SourceFilter{Includes: []string{"Name"}}},
+	}
+	docs, err := elasticsearch.MultiGetTyped[Product](ctx, client, items)
+	helper.OK(t, err)
+	helper.Equals(t, 3, len(docs))
+	helper.Assert(t, docs[0].Found && docs[0].Value.Name == "Polo", "The documents are not in the request order")
+	helper.Assert(t, !docs[1].Found, "A missing document has been found")
+	helper.Assert(t, docs[2].Found && docs[2].Value.Name == "Jeans", "The documents are not in the request order")
+
+	//Delete the index
+	deleteResponse, err := client.DeleteIndex(IndexName)
+	helper.OK(t, err)
+	helper.Assert(t, deleteResponse.Acknowledged, "Index has not been deleted")
+}
+
+func TestUpdateDocument(t *testing.T) {
+	type Product struct {
+		Name  string
+		Color string
+	}
+
+	helper := Test{}
+	ctx := context.Background()
+	client := elasticsearch.NewClient(ESScheme, ESHost, ESPort)
+	client.SetHttpTimeout(5 * time.Second)
+	client.CreateIndex(IndexName, IndexMapping)
+
+	//Upsert
+	updateResponse, err := client.UpdateDocument(ctx, IndexName, "1234", elasticsearch.UpdateRequest{Doc: json.RawMessage(`{"Name":"Jeans"}`), DocAsUpsert: true})
+	helper.OK(t, err)
+	helper.Assert(t, updateResponse.Result == elasticsearch.ResultCreated, "The document has not been upserted")
+
+	//Partial update
+	updateResponse, err = client.UpdateDocument(ctx, IndexName, "1234", elasticsearch.UpdateRequest{Doc: json.RawMessage(`{"Color":"blue"}`), Source: true})
+	helper.OK(t, err)
+	helper.Assert(t, updateResponse.Result == elasticsearch.ResultUpdated, "The document has not been updated")
+
+	var p Product
+	err = json.Unmarshal(updateResponse.Get.Source, &p)
+	helper.OK(t, err)
+	helper.Equals(t, Product{Name: "Jeans", Color: "blue"}, p)
+
+	//Noop
+	updateResponse, err = client.UpdateDocument(ctx, IndexName, "1234", elasticsearch.UpdateRequest{Doc: json.RawMessage(`{"Color":"blue"}`)})
+	helper.OK(t, err)
+	helper.Assert(t, updateResponse.Result == elasticsearch.ResultNoop, "The update should have been a noop")
+
+	//Script
+	script := &elasticsearch.Script{Source: "ctx._source.Color = params.color", Params: map[string]interface{}{"color": "red"}}
+	updateResponse, err = client.UpdateDocument(ctx, IndexName, "1234", elasticsearch.UpdateRequest{Script: script, RetryOnConflict: 3})
+	helper.OK(t, err)
+	helper.Assert(t, updateResponse.Result == elasticsearch.ResultUpdated, "The script has not updated the document")
+
+	//Delete the index
+	deleteResponse, err := client.DeleteIndex(IndexName)
+	helper.OK(t, err)
+	helper.Assert(t, deleteResponse.Acknowledged, "Index has not been deleted")
+}
+
 func TestSearch(t *testing.T) {
 	type Product struct {
 		Name   string
@@ -154,16 +492,13 @@ func TestSearch(t *testing.T) {
 		json.NewEncoder(buffer).Encode(value)
 	}
 
-	_, err := client.Bulk(buffer.Bytes())
+	_, err := client.Bulk(buffer.Bytes(), elasticsearch.WithRefresh(elasticsearch.RefreshWaitFor))
 	helper.OK(t, err)
 
-	//We have to wait after a bulk
-	time.Sleep(1500 * time.Millisecond)
-
 	//Search
 	search, err := client.Search(IndexName, ProductDocumentType, SearchByColorQuery("red"), false)
 	helper.OK(t, err)
-	helper.Assert(t, search.Hits.Total == 2, "The search doesn't return all matched items")
+	helper.Assert(t, search.Hits.Total.Value == 2, "The search doesn't return all matched items")
 
 	//MSearch
 
@@ -0,0 +1,83 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// CountResult represents the result of the count operation
+type CountResult struct {
+	Count  int64      `json:"count"`
+	Shards ShardsInfo `json:"_shards"`
+}
+
+// queryBody returns the body of a request holding only a query
+func queryBody(query json.RawMessage) (*bytes.Buffer, error) {
+	body, err := json.Marshal(struct {
+		Query json.RawMessage `json:"query"`
+	}{query})
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewBuffer(body), nil
+}
+
+func (c *client) Count(ctx context.Context, indices []string, query json.RawMessage, opts ...RequestOption) (*CountResult, error) {
+	var reader io.Reader
+	if len(query) > 0 {
+		body, err := queryBody(query)
+		if err != nil {
+			return &CountResult{}, err
+		}
+		reader = body
+	}
+
+	url := buildURL(c.Host.String()+indicesPath(indices)+"/_count", requestParams(opts))
+	response, err := sendHTTPRequestWithContext(ctx, "POST", url, reader, c.Timeout)
+	if err != nil {
+		return &CountResult{}, err
+	}
+
+	esResp := &CountResult{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &CountResult{}, err
+	}
+
+	return esResp, nil
+}
+
+func (c *client) CatCount(ctx context.Context, indices ...string) (int64, error) {
+	url := c.Host.String() + "/_cat/count"
+	if len(indices) > 0 {
+		url += "/" + strings.Join(indices, ",")
+	}
+	url += "?format=json"
+	response, err := sendHTTPRequestWithContext(ctx, "GET", url, nil, c.Timeout)
+	if err != nil {
+		return 0, err
+	}
+
+	var rows []struct {
+		Count string `json:"count"`
+	}
+	err = json.Unmarshal(response, &rows)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	for _, row := range rows {
+		n, err := strconv.ParseInt(row.Count, 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		count += n
+	}
+
+	return count, nil
+}
@@ -0,0 +1,63 @@
+package elasticsearch_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/boes13/elasticsearch"
+)
+
+func TestCount(t *testing.T) {
+	helper := Test{}
+	ctx := context.Background()
+
+	server := NewRecorder(Reply(http.StatusOK, `{"count": 42, "_shards": {"total": 2, "successful": 2, "skipped": 0, "failed": 0}}`))
+	defer server.Close()
+	client := server.Client()
+
+	result, err := client.Count(ctx, []string{"products", "archive"}, json.RawMessage(`{"term": {"Name": "jeans"}}`), elasticsearch.WithRouting("user1"))
+	helper.OK(t, err)
+	helper.Equals(t, int64(42), result.Count)
+	helper.Equals(t, 2, result.Shards.Successful)
+
+	_, err = client.Count(ctx, nil, nil)
+	helper.OK(t, err)
+
+	helper.Equals(t, []string{"POST /products,archive/_count?routing=user1", "POST /_count"}, server.Requests)
+	helper.Equals(t, []string{`{"query":{"term":{"Name":"jeans"}}}`, ""}, server.Bodies)
+}
+
+func TestCatCount(t *testing.T) {
+	helper := Test{}
+	ctx := context.Background()
+
+	server := NewRecorder(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/_cat/count/broken" {
+			w.Write([]byte(`[{"epoch": "1700000000", "timestamp": "22:13:20", "count": "many"}]`))
+			return
+		}
+		w.Write([]byte(`[{"epoch": "1700000000", "timestamp": "22:13:20", "count": "40"},
+			{"epoch": "1700000000", "timestamp": "22:13:20", "count": "2"}]`))
+	})
+	defer server.Close()
+	client := server.Client()
+
+	count, err := client.CatCount(ctx, "products", "archive")
+	helper.OK(t, err)
+	helper.Equals(t, int64(42), count)
+
+	count, err = client.CatCount(ctx)
+	helper.OK(t, err)
+	helper.Equals(t, int64(42), count)
+
+	_, err = client.CatCount(ctx, "broken")
+	helper.Assert(t, err != nil, "An invalid count should be reported")
+
+	helper.Equals(t, []string{
+		"GET /_cat/count/products,archive?format=json",
+		"GET /_cat/count?format=json",
+		"GET /_cat/count/broken?format=json",
+	}, server.Requests)
+}
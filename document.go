@@ -0,0 +1,126 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strconv"
+)
+
+// Result of a write operation on a document
+const (
+	ResultCreated  = "created"
+	ResultUpdated  = "updated"
+	ResultDeleted  = "deleted"
+	ResultNotFound = "not_found"
+	ResultNoop     = "noop"
+)
+
+// UpdateRequest represents a partial update of a document, either through Doc or through Script.
+// RetryOnConflict is sent as a URL parameter, use WithRefresh to make the change visible to search.
+type UpdateRequest struct {
+	Doc            json.RawMessage `json:"doc,omitempty"`
+	DocAsUpsert    bool            `json:"doc_as_upsert,omitempty"`
+	Upsert         json.RawMessage `json:"upsert,omitempty"`
+	Script         *Script         `json:"script,omitempty"`
+	ScriptedUpsert bool            `json:"scripted_upsert,omitempty"`
+	DetectNoop     *bool           `json:"detect_noop,omitempty"` // enabled by the search engine when nil
+	Source         interface{}     `json:"_source,omitempty"`     // true, false or a list of fields to return
+
+	RetryOnConflict int `json:"-"`
+}
+
+// UpdateDocument represents the result of the update operation of a document
+type UpdateDocument struct {
+	Index       string `json:"_index"`
+	Type        string `json:"_type"`
+	ID          string `json:"_id"`
+	Version     int    `json:"_version"`
+	SeqNo       int64  `json:"_seq_no"`
+	PrimaryTerm int64  `json:"_primary_term"`
+	Result      string `json:"result"`
+	Shards      struct {
+		Total      int `json:"total"`
+		Successful int `json:"successful"`
+		Failed     int `json:"failed"`
+	} `json:"_shards"`
+	Get *struct {
+		Found  bool            `json:"found"`
+		Source json.RawMessage `json:"_source"`
+	} `json:"get,omitempty"`
+}
+
+func (c *client) UpdateDocument(ctx context.Context, indexName, identifier string, req UpdateRequest, opts ...RequestOption) (*UpdateDocument, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return &UpdateDocument{}, err
+	}
+
+	params := requestParams(opts)
+	if req.RetryOnConflict > 0 {
+		params.Set("retry_on_conflict", strconv.Itoa(req.RetryOnConflict))
+	}
+
+	url := buildURL(c.Host.String()+"/"+indexName+"/_update/"+identifier, params)
+	response, err := sendHTTPRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body), c.Timeout)
+	if err != nil {
+		return &UpdateDocument{}, err
+	}
+
+	esResp := &UpdateDocument{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &UpdateDocument{}, err
+	}
+
+	return esResp, nil
+}
+
+func (c *client) CreateDocument(ctx context.Context, indexName, identifier string, data []byte, opts ...RequestOption) (*InsertDocument, error) {
+	url := buildURL(c.Host.String()+"/"+indexName+"/_create/"+identifier, requestParams(opts))
+	reader := bytes.NewBuffer(data)
+	response, err := sendHTTPRequestWithContext(ctx, "PUT", url, reader, c.Timeout)
+	if err != nil {
+		return &InsertDocument{}, err
+	}
+
+	esResp := &InsertDocument{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &InsertDocument{}, err
+	}
+
+	return esResp, nil
+}
+
+func (c *client) IndexDocument(ctx context.Context, indexName string, data []byte, opts ...RequestOption) (*InsertDocument, error) {
+	url := buildURL(c.Host.String()+"/"+indexName+"/_doc", requestParams(opts))
+	reader := bytes.NewBuffer(data)
+	response, err := sendHTTPRequestWithContext(ctx, "POST", url, reader, c.Timeout)
+	if err != nil {
+		return &InsertDocument{}, err
+	}
+
+	esResp := &InsertDocument{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &InsertDocument{}, err
+	}
+
+	return esResp, nil
+}
+
+func (c *client) DocumentExists(ctx context.Context, indexName, identifier string, opts ...RequestOption) (bool, error) {
+	url := buildURL(c.Host.String()+"/"+indexName+"/_doc/"+identifier, requestParams(opts))
+	return sendHeadRequest(ctx, url, c.Timeout)
+}
+
+func (c *client) DocumentSource(ctx context.Context, indexName, identifier string, opts ...RequestOption) (json.RawMessage, error) {
+	url := buildURL(c.Host.String()+"/"+indexName+"/_source/"+identifier, requestParams(opts))
+	response, err := sendHTTPRequestWithContext(ctx, "GET", url, nil, c.Timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(response), nil
+}
@@ -0,0 +1,64 @@
+package elasticsearch_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/boes13/elasticsearch"
+)
+
+func TestUpdateMissingDocument(t *testing.T) {
+	helper := Test{}
+
+	server := NewRecorder(Reply(http.StatusNotFound, `{"error": {"type": "document_missing_exception", "reason": "[_doc][42]: document missing"}, "status": 404}`))
+	defer server.Close()
+	client := server.Client()
+
+	_, err := client.UpdateDocument(context.Background(), "products", "42", elasticsearch.UpdateRequest{
+		Doc: json.RawMessage(`{"Name": "Jeans"}`),
+	})
+	helper.Equals(t, []string{"POST /products/_update/42"}, server.Requests)
+	helper.Assert(t, errors.Is(err, elasticsearch.ErrNotFound), "A missing document should match ErrNotFound, got %v", err)
+
+	var requestErr *elasticsearch.RequestError
+	helper.Assert(t, errors.As(err, &requestErr), "The error should be a RequestError")
+	helper.Assert(t, requestErr.StatusCode == http.StatusNotFound, "Unexpected status code %d", requestErr.StatusCode)
+}
+
+func TestDocumentExists(t *testing.T) {
+	helper := Test{}
+	ctx := context.Background()
+
+	server := NewRecorder(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/products/_doc/1":
+			w.WriteHeader(http.StatusOK)
+		case "/products/_doc/2":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	})
+	defer server.Close()
+	client := server.Client()
+
+	exists, err := client.DocumentExists(ctx, "products", "1", elasticsearch.WithRouting("user1"))
+	helper.OK(t, err)
+	helper.Assert(t, exists, "The document should exist")
+
+	exists, err = client.DocumentExists(ctx, "products", "2")
+	helper.OK(t, err)
+	helper.Assert(t, !exists, "The document should not exist")
+
+	//An unavailable cluster is not a missing document
+	exists, err = client.DocumentExists(ctx, "products", "3")
+	var requestErr *elasticsearch.RequestError
+	helper.Assert(t, errors.As(err, &requestErr), "A 503 response should be returned as a RequestError, got %v", err)
+	helper.Equals(t, http.StatusServiceUnavailable, requestErr.StatusCode)
+	helper.Assert(t, !exists, "The document should not be reported as existing")
+
+	helper.Equals(t, []string{"HEAD /products/_doc/1?routing=user1", "HEAD /products/_doc/2", "HEAD /products/_doc/3"}, server.Requests)
+}
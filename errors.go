@@ -0,0 +1,69 @@
+package elasticsearch
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// ErrVersionConflict is matched by errors.Is when a conditional write is rejected because the document
+// has been modified concurrently, or when a document to create already exists
+var ErrVersionConflict = errors.New("elasticsearch: version conflict")
+
+// ErrNotFound is matched by errors.Is when the requested resource does not exist
+var ErrNotFound = errors.New("elasticsearch: not found")
+
+// ErrMissingIndex is returned when an operation requiring at least one index is given none
+var ErrMissingIndex = errors.New("elasticsearch: at least one index is required")
+
+// ErrMissingName is returned when an operation requiring the name of a resource, e.g. a policy, is given none
+var ErrMissingName = errors.New("elasticsearch: a name is required")
+
+// ErrMissingQuery is returned when an operation requiring a query is given none
+var ErrMissingQuery = errors.New("elasticsearch: a query is required")
+
+// ErrUnsupported is returned when the operation is not supported by the search engine, e.g. by OpenSearch
+var ErrUnsupported = errors.New("elasticsearch: operation not supported by the search engine")
+
+// RequestError represents an error response sent back by the search engine
+type RequestError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *RequestError) Error() string {
+	return e.Body
+}
+
+// Is reports whether the error matches target, e.g. ErrVersionConflict on a 409 response
+func (e *RequestError) Is(target error) bool {
+	switch target {
+	case ErrVersionConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	}
+	return false
+}
+
+// RetryOnConflict runs fn, typically a read-modify-write of a document using WithIfSeqNo and
+// WithIfPrimaryTerm, until it returns an error which is not ErrVersionConflict or maxAttempts is reached.
+// fn must read the document again on each attempt, and always runs at least once.
+func RetryOnConflict(ctx context.Context, maxAttempts int, fn func(ctx context.Context) error) error {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	var err error
+	for i := 0; i < maxAttempts; i++ {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		err = fn(ctx)
+		if !errors.Is(err, ErrVersionConflict) {
+			return err
+		}
+	}
+	return err
+}
@@ -0,0 +1,104 @@
+package elasticsearch_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/boes13/elasticsearch"
+)
+
+func TestRetryOnConflict(t *testing.T) {
+	helper := Test{}
+	ctx := context.Background()
+	conflict := &elasticsearch.RequestError{StatusCode: http.StatusConflict, Body: "version_conflict_engine_exception"}
+	helper.Assert(t, errors.Is(conflict, elasticsearch.ErrVersionConflict), "A 409 response should match ErrVersionConflict")
+
+	badRequest := &elasticsearch.RequestError{StatusCode: http.StatusBadRequest}
+	helper.Assert(t, !errors.Is(badRequest, elasticsearch.ErrVersionConflict), "A 400 response should not match ErrVersionConflict")
+
+	//Succeeds after conflicts
+	attempts := 0
+	err := elasticsearch.RetryOnConflict(ctx, 3, func(ctx context.Context) error {
+		attempts++
+		if attempts < 3 {
+			return conflict
+		}
+		return nil
+	})
+	helper.OK(t, err)
+	helper.Equals(t, 3, attempts)
+
+	//Gives up after maxAttempts
+	attempts = 0
+	err = elasticsearch.RetryOnConflict(ctx, 2, func(ctx context.Context) error {
+		attempts++
+		return conflict
+	})
+	helper.Assert(t, errors.Is(err, elasticsearch.ErrVersionConflict), "The last conflict should be returned")
+	helper.Equals(t, 2, attempts)
+
+	//Stops on other errors
+	attempts = 0
+	err = elasticsearch.RetryOnConflict(ctx, 5, func(ctx context.Context) error {
+		attempts++
+		return badRequest
+	})
+	helper.Equals(t, badRequest, err)
+	helper.Equals(t, 1, attempts)
+
+	//Runs once when maxAttempts is not positive
+	for _, maxAttempts := range []int{0, -1} {
+		attempts = 0
+		err = elasticsearch.RetryOnConflict(ctx, maxAttempts, func(ctx context.Context) error {
+			attempts++
+			return conflict
+		})
+		helper.Assert(t, errors.Is(err, elasticsearch.ErrVersionConflict), "The conflict should be returned")
+		helper.Equals(t, 1, attempts)
+	}
+}
+
+func TestRequestErrors(t *testing.T) {
+	helper := Test{}
+	ctx := context.Background()
+
+	//404 for missing resources and 500 for everything else
+	server := NewRecorder(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/missing/_count":
+			Reply(http.StatusNotFound, `{"error": {"type": "index_not_found_exception"}, "status": 404}`)(w, r)
+		case "/products/_doc/missing":
+			Reply(http.StatusNotFound, `{"_index": "products", "_type": "_doc", "_id": "missing", "found": false}`)(w, r)
+		default:
+			Reply(http.StatusInternalServerError, `{"error": {"type": "illegal_state_exception"}, "status": 500}`)(w, r)
+		}
+	})
+	defer server.Close()
+	client := server.Client()
+
+	_, err := client.Count(ctx, []string{"missing"}, nil)
+	helper.Assert(t, errors.Is(err, elasticsearch.ErrNotFound), "A 404 response should match ErrNotFound, got %v", err)
+
+	_, err = client.SearchWithRequest(ctx, elasticsearch.SearchRequest{Indices: []string{"products"}})
+	var requestErr *elasticsearch.RequestError
+	helper.Assert(t, errors.As(err, &requestErr), "A 500 response should be returned as a RequestError, got %v", err)
+	helper.Equals(t, http.StatusInternalServerError, requestErr.StatusCode)
+	helper.Assert(t, !errors.Is(err, elasticsearch.ErrNotFound), "A 500 response should not match ErrNotFound")
+
+	_, err = client.Reindex(ctx, elasticsearch.ReindexRequest{
+		Source: elasticsearch.ReindexSource{Index: []string{"products"}},
+		Dest:   elasticsearch.ReindexDestination{Index: "products-copy"},
+	})
+	helper.Assert(t, errors.As(err, &requestErr), "A 500 response should be returned as a RequestError, got %v", err)
+	helper.Equals(t, http.StatusInternalServerError, requestErr.StatusCode)
+
+	//The legacy document getter keeps reporting a missing document as not found
+	document, err := client.Document("products", "_doc", "missing")
+	helper.OK(t, err)
+	helper.Assert(t, !document.Found, "The missing document should not be found")
+
+	_, err = client.Document("products", "_doc", "broken")
+	helper.Assert(t, errors.As(err, &requestErr), "A 500 response should be returned as a RequestError, got %v", err)
+}
@@ -0,0 +1,85 @@
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// ExplainResult represents how the score of a document is computed for a query
+type ExplainResult struct {
+	Index       string       `json:"_index"`
+	Type        string       `json:"_type"`
+	ID          string       `json:"_id"`
+	Matched     bool         `json:"matched"`
+	Explanation *Explanation `json:"explanation"`
+}
+
+// QueryExplanation represents the validation of a query on an index or a shard.
+// Explanation is the query as rewritten by the search engine, Error why it is invalid.
+type QueryExplanation struct {
+	Index       string `json:"index"`
+	Shard       *int   `json:"shard,omitempty"`
+	Valid       bool   `json:"valid"`
+	Explanation string `json:"explanation,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// ValidateQueryResult represents the result of the validation of a query
+type ValidateQueryResult struct {
+	Valid        bool               `json:"valid"`
+	Shards       ShardsInfo         `json:"_shards"`
+	Explanations []QueryExplanation `json:"explanations,omitempty"`
+	Error        string             `json:"error,omitempty"`
+}
+
+func (c *client) Explain(ctx context.Context, indexName, identifier string, query json.RawMessage, opts ...RequestOption) (*ExplainResult, error) {
+	reader, err := queryBody(query)
+	if err != nil {
+		return &ExplainResult{}, err
+	}
+
+	url := buildURL(c.Host.String()+"/"+indexName+"/_explain/"+identifier, requestParams(opts))
+	response, err := sendHTTPRequestWithContext(ctx, "POST", url, reader, c.Timeout)
+	if err != nil {
+		return &ExplainResult{}, err
+	}
+
+	esResp := &ExplainResult{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &ExplainResult{}, err
+	}
+
+	return esResp, nil
+}
+
+func (c *client) ValidateQuery(ctx context.Context, indexName string, query json.RawMessage, explain, rewrite bool) (*ValidateQueryResult, error) {
+	reader, err := queryBody(query)
+	if err != nil {
+		return &ValidateQueryResult{}, err
+	}
+
+	params := url.Values{}
+	if explain {
+		params.Set("explain", strconv.FormatBool(explain))
+	}
+	if rewrite {
+		params.Set("rewrite", strconv.FormatBool(rewrite))
+	}
+
+	url := buildURL(c.Host.String()+"/"+indexName+"/_validate/query", params)
+	response, err := sendHTTPRequestWithContext(ctx, "POST", url, reader, c.Timeout)
+	if err != nil {
+		return &ValidateQueryResult{}, err
+	}
+
+	esResp := &ValidateQueryResult{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &ValidateQueryResult{}, err
+	}
+
+	return esResp, nil
+}
@@ -0,0 +1,67 @@
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// FieldCapability represents the capabilities of a field for one of its types.
+// Indices, NonSearchableIndices and NonAggregatableIndices are only set when the indices disagree.
+type FieldCapability struct {
+	Type                   string   `json:"type"`
+	Searchable             bool     `json:"searchable"`
+	Aggregatable           bool     `json:"aggregatable"`
+	MetadataField          bool     `json:"metadata_field,omitempty"`
+	Indices                []string `json:"indices,omitempty"`
+	NonSearchableIndices   []string `json:"non_searchable_indices,omitempty"`
+	NonAggregatableIndices []string `json:"non_aggregatable_indices,omitempty"`
+}
+
+// FieldCapsResult represents the capabilities of the fields, by field name and type
+type FieldCapsResult struct {
+	Indices []string                              `json:"indices"`
+	Fields  map[string]map[string]FieldCapability `json:"fields"`
+}
+
+// Conflicts returns the fields mapped with different types across the indices, along with their sorted types
+func (r *FieldCapsResult) Conflicts() map[string][]string {
+	conflicts := make(map[string][]string)
+	for field, capabilities := range r.Fields {
+		if len(capabilities) < 2 {
+			continue
+		}
+
+		types := make([]string, 0, len(capabilities))
+		for fieldType := range capabilities {
+			types = append(types, fieldType)
+		}
+		sort.Strings(types)
+		conflicts[field] = types
+	}
+	return conflicts
+}
+
+func (c *client) FieldCaps(ctx context.Context, indices []string, fields ...string) (*FieldCapsResult, error) {
+	if len(fields) == 0 {
+		fields = []string{"*"}
+	}
+	params := url.Values{}
+	params.Set("fields", strings.Join(fields, ","))
+
+	url := buildURL(c.Host.String()+indicesPath(indices)+"/_field_caps", params)
+	response, err := sendHTTPRequestWithContext(ctx, "GET", url, nil, c.Timeout)
+	if err != nil {
+		return &FieldCapsResult{}, err
+	}
+
+	esResp := &FieldCapsResult{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &FieldCapsResult{}, err
+	}
+
+	return esResp, nil
+}
@@ -1,11 +1,17 @@
 package elasticsearch_test
 
 import (
+	"bytes"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"path/filepath"
 	"reflect"
 	"runtime"
 	"testing"
+
+	"github.com/boes13/elasticsearch"
 )
 
 //https://github.com/benbjohnson/testing
@@ -39,3 +45,37 @@ func (t *Test) Equals(tb testing.TB, exp, act interface{}) {
 		tb.FailNow()
 	}
 }
+
+// Recorder is a local stand-in of the search engine which records the requests it receives,
+// so that the test asserts on them once the client returns.
+type Recorder struct {
+	*httptest.Server
+	Requests []string // method and request URI, e.g. "POST /products/_count?routing=1"
+	Bodies   []string
+}
+
+// NewRecorder starts a Recorder answering the requests with handler, which can still read the body
+func NewRecorder(handler http.HandlerFunc) *Recorder {
+	recorder := &Recorder{}
+	recorder.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		recorder.Requests = append(recorder.Requests, r.Method+" "+r.URL.RequestURI())
+		recorder.Bodies = append(recorder.Bodies, string(body))
+		handler(w, r)
+	}))
+	return recorder
+}
+
+// Reply returns a handler answering every request with the status code and body
+func Reply(statusCode int, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(statusCode)
+		w.Write([]byte(body))
+	}
+}
+
+// Client returns a client sending its requests to the recorder
+func (r *Recorder) Client() elasticsearch.Client {
+	return elasticsearch.NewClientFromUrl(r.URL)
+}
@@ -0,0 +1,103 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+// highlightTags matches the tags surrounding the highlighted terms of a fragment, e.g. <em> and </em>
+var highlightTags = regexp.MustCompile(`<[^>]*>`)
+
+// Highlighter types
+const (
+	HighlighterUnified = "unified"
+	HighlighterPlain   = "plain"
+	HighlighterFvh     = "fvh"
+)
+
+// HighlightField represents the highlighting settings of a field, overriding the global ones
+type HighlightField struct {
+	PreTags           []string        `json:"pre_tags,omitempty"`
+	PostTags          []string        `json:"post_tags,omitempty"`
+	FragmentSize      int             `json:"fragment_size,omitempty"`
+	NumberOfFragments *int            `json:"number_of_fragments,omitempty"` // 0 highlights the whole field
+	Type              string          `json:"type,omitempty"`
+	RequireFieldMatch *bool           `json:"require_field_match,omitempty"`
+	HighlightQuery    json.RawMessage `json:"highlight_query,omitempty"`
+}
+
+// Highlight represents the highlighting settings of a search request
+type Highlight struct {
+	Fields            map[string]HighlightField `json:"fields"`
+	PreTags           []string                  `json:"pre_tags,omitempty"`
+	PostTags          []string                  `json:"post_tags,omitempty"`
+	FragmentSize      int                       `json:"fragment_size,omitempty"`
+	NumberOfFragments *int                      `json:"number_of_fragments,omitempty"` // 0 highlights the whole field
+	Type              string                    `json:"type,omitempty"`
+	RequireFieldMatch *bool                     `json:"require_field_match,omitempty"`
+	HighlightQuery    json.RawMessage           `json:"highlight_query,omitempty"`
+}
+
+// HighlightedSource decodes the _source of the hit into v after replacing each highlighted field
+// by its fragments, joined with separator for text fields. In array fields only the elements a fragment
+// was taken from are highlighted, which requires HTML-like tags. Nested fields are addressed with dots, e.g. brand.name.
+func (h Hit) HighlightedSource(v interface{}, separator string) error {
+	source := map[string]interface{}{}
+	if len(h.Source) > 0 {
+		dec := json.NewDecoder(bytes.NewReader(h.Source))
+		dec.UseNumber()
+		err := dec.Decode(&source)
+		if err != nil {
+			return err
+		}
+	}
+
+	for field, fragments := range h.Highlight {
+		parent := source
+		path := strings.Split(field, ".")
+		for _, name := range path[:len(path)-1] {
+			child, ok := parent[name].(map[string]interface{})
+			if !ok {
+				parent = nil
+				break
+			}
+			parent = child
+		}
+		if parent == nil {
+			continue
+		}
+
+		name := path[len(path)-1]
+		if values, ok := parent[name].([]interface{}); ok {
+			highlightElements(values, fragments)
+		} else {
+			parent[name] = strings.Join(fragments, separator)
+		}
+	}
+
+	data, err := json.Marshal(source)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// highlightElements replaces, in the string elements of an array field, the text each fragment was taken from
+// by the fragment itself
+func highlightElements(values []interface{}, fragments []string) {
+	highlighted := make([]bool, len(values))
+	for _, fragment := range fragments {
+		text := highlightTags.ReplaceAllString(fragment, "")
+		for i, value := range values {
+			element, ok := value.(string)
+			if !ok || highlighted[i] || !strings.Contains(element, text) {
+				continue
+			}
+			values[i] = strings.Replace(element, text, fragment, 1)
+			highlighted[i] = true
+			break
+		}
+	}
+}
@@ -0,0 +1,369 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// indicesPath returns the path prefix targeting the indices, or all the indices when none is given
+func indicesPath(indices []string) string {
+	if len(indices) == 0 {
+		return ""
+	}
+	return "/" + strings.Join(indices, ",")
+}
+
+func (c *client) Refresh(ctx context.Context, indices ...string) (*ShardsResponse, error) {
+	url := c.Host.String() + indicesPath(indices) + "/_refresh"
+	response, err := sendHTTPRequestWithContext(ctx, "POST", url, nil, c.Timeout)
+	if err != nil {
+		return &ShardsResponse{}, err
+	}
+
+	esResp := &ShardsResponse{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &ShardsResponse{}, err
+	}
+
+	return esResp, nil
+}
+
+func (c *client) Flush(ctx context.Context, indices ...string) (*ShardsResponse, error) {
+	url := c.Host.String() + indicesPath(indices) + "/_flush"
+	response, err := sendHTTPRequestWithContext(ctx, "POST", url, nil, c.Timeout)
+	if err != nil {
+		return &ShardsResponse{}, err
+	}
+
+	esResp := &ShardsResponse{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &ShardsResponse{}, err
+	}
+
+	return esResp, nil
+}
+
+// IndexResponse represents the acknowledgement of an operation on an index.
+// ShardsAcknowledged is false when the shards did not start before the timeout.
+type IndexResponse struct {
+	Acknowledged       bool   `json:"acknowledged"`
+	ShardsAcknowledged bool   `json:"shards_acknowledged"`
+	Index              string `json:"index,omitempty"`
+}
+
+// CloseIndexResponse represents the result of the close operation of indices
+type CloseIndexResponse struct {
+	Acknowledged       bool `json:"acknowledged"`
+	ShardsAcknowledged bool `json:"shards_acknowledged"`
+	Indices            map[string]struct {
+		Closed bool `json:"closed"`
+	} `json:"indices"`
+}
+
+// ResizeRequest represents the settings and aliases of the target index of a shrink, split or clone operation
+type ResizeRequest struct {
+	Settings json.RawMessage            `json:"settings,omitempty"`
+	Aliases  map[string]AliasDefinition `json:"aliases,omitempty"`
+}
+
+// RolloverConditions represents the conditions of a rollover, it happens as soon as one is met
+type RolloverConditions struct {
+	MaxAge              string `json:"max_age,omitempty"` // e.g. 7d
+	MaxDocs             int64  `json:"max_docs,omitempty"`
+	MaxSize             string `json:"max_size,omitempty"` // e.g. 50gb
+	MaxPrimaryShardSize string `json:"max_primary_shard_size,omitempty"`
+}
+
+// RolloverRequest represents a rollover of an alias to a new index.
+// NewIndex is generated from the current index name when empty, e.g. logs-000002 after logs-000001.
+// NewIndex and DryRun are sent as URL parameters.
+type RolloverRequest struct {
+	Conditions RolloverConditions         `json:"conditions"`
+	Settings   json.RawMessage            `json:"settings,omitempty"`
+	Mappings   json.RawMessage            `json:"mappings,omitempty"`
+	Aliases    map[string]AliasDefinition `json:"aliases,omitempty"`
+
+	NewIndex string `json:"-"`
+	DryRun   bool   `json:"-"` // only check the conditions
+}
+
+// RolloverResponse represents the result of a rollover.
+// Conditions holds whether each condition is met, keyed by its description, e.g. [max_docs: 1000].
+type RolloverResponse struct {
+	Acknowledged       bool            `json:"acknowledged"`
+	ShardsAcknowledged bool            `json:"shards_acknowledged"`
+	OldIndex           string          `json:"old_index"`
+	NewIndex           string          `json:"new_index"`
+	RolledOver         bool            `json:"rolled_over"`
+	DryRun             bool            `json:"dry_run"`
+	Conditions         map[string]bool `json:"conditions"`
+	AliasIndices       []string        `json:"-"` // sorted indices of the alias, only set once rolled over
+}
+
+// WithWaitForActiveShards sets the number of shard copies which must be active before the operation returns,
+// e.g. 1 or all
+func WithWaitForActiveShards(count string) RequestOption {
+	return func(params url.Values) {
+		params.Set("wait_for_active_shards", count)
+	}
+}
+
+// indexAction runs a bodyless action on the indices and decodes the response into v.
+// At least one index is required, the actions are not meant to target all the indices at once.
+func (c *client) indexAction(ctx context.Context, indices []string, action string, opts []RequestOption, v interface{}) error {
+	if strings.Join(indices, "") == "" {
+		return ErrMissingIndex
+	}
+
+	url := buildURL(c.Host.String()+"/"+strings.Join(indices, ",")+"/"+action, requestParams(opts))
+	response, err := sendHTTPRequestWithContext(ctx, "POST", url, nil, c.Timeout)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(response, v)
+}
+
+func (c *client) OpenIndex(ctx context.Context, indices []string, opts ...RequestOption) (*IndexResponse, error) {
+	esResp := &IndexResponse{}
+	err := c.indexAction(ctx, indices, "_open", opts, esResp)
+	if err != nil {
+		return &IndexResponse{}, err
+	}
+
+	return esResp, nil
+}
+
+func (c *client) CloseIndex(ctx context.Context, indices []string, opts ...RequestOption) (*CloseIndexResponse, error) {
+	esResp := &CloseIndexResponse{}
+	err := c.indexAction(ctx, indices, "_close", opts, esResp)
+	if err != nil {
+		return &CloseIndexResponse{}, err
+	}
+
+	return esResp, nil
+}
+
+func (c *client) FreezeIndex(ctx context.Context, indexName string, opts ...RequestOption) (*IndexResponse, error) {
+	esResp := &IndexResponse{}
+	err := c.indexAction(ctx, []string{indexName}, "_freeze", opts, esResp)
+	if err != nil {
+		return &IndexResponse{}, err
+	}
+
+	return esResp, nil
+}
+
+func (c *client) UnfreezeIndex(ctx context.Context, indexName string, opts ...RequestOption) (*IndexResponse, error) {
+	esResp := &IndexResponse{}
+	err := c.indexAction(ctx, []string{indexName}, "_unfreeze", opts, esResp)
+	if err != nil {
+		return &IndexResponse{}, err
+	}
+
+	return esResp, nil
+}
+
+// resizeIndex runs a shrink, split or clone operation of source into target
+func (c *client) resizeIndex(ctx context.Context, action, source, target string, req ResizeRequest, opts []RequestOption) (*IndexResponse, error) {
+	if source == "" || target == "" {
+		return &IndexResponse{}, ErrMissingIndex
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return &IndexResponse{}, err
+	}
+
+	url := buildURL(c.Host.String()+"/"+source+"/"+action+"/"+target, requestParams(opts))
+	response, err := sendHTTPRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body), c.Timeout)
+	if err != nil {
+		return &IndexResponse{}, err
+	}
+
+	esResp := &IndexResponse{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &IndexResponse{}, err
+	}
+
+	return esResp, nil
+}
+
+func (c *client) ShrinkIndex(ctx context.Context, source, target string, req ResizeRequest, opts ...RequestOption) (*IndexResponse, error) {
+	return c.resizeIndex(ctx, "_shrink", source, target, req, opts)
+}
+
+func (c *client) SplitIndex(ctx context.Context, source, target string, req ResizeRequest, opts ...RequestOption) (*IndexResponse, error) {
+	return c.resizeIndex(ctx, "_split", source, target, req, opts)
+}
+
+func (c *client) CloneIndex(ctx context.Context, source, target string, req ResizeRequest, opts ...RequestOption) (*IndexResponse, error) {
+	return c.resizeIndex(ctx, "_clone", source, target, req, opts)
+}
+
+func (c *client) Rollover(ctx context.Context, alias string, req RolloverRequest, opts ...RequestOption) (*RolloverResponse, error) {
+	if alias == "" {
+		return &RolloverResponse{}, ErrMissingIndex
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return &RolloverResponse{}, err
+	}
+
+	params := requestParams(opts)
+	if req.DryRun {
+		params.Set("dry_run", "true")
+	}
+	path := c.Host.String() + "/" + alias + "/_rollover"
+	if req.NewIndex != "" {
+		path += "/" + req.NewIndex
+	}
+
+	url := buildURL(path, params)
+	response, err := sendHTTPRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body), c.Timeout)
+	if err != nil {
+		return &RolloverResponse{}, err
+	}
+
+	esResp := &RolloverResponse{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &RolloverResponse{}, err
+	}
+
+	if esResp.RolledOver {
+		esResp.AliasIndices, err = c.indicesFromAlias(ctx, alias)
+		if err != nil {
+			return &RolloverResponse{}, err
+		}
+	}
+
+	return esResp, nil
+}
+
+// ForceMergeResponse represents the result of a force merge.
+// When it runs asynchronously only Task is set.
+type ForceMergeResponse struct {
+	Shards ShardsInfo `json:"_shards"`
+	Task   string     `json:"task,omitempty"`
+}
+
+// ClearCacheOptions selects the caches to clear, all of them when none is set
+type ClearCacheOptions struct {
+	Fielddata bool
+	Query     bool
+	Request   bool
+	Fields    []string // limits the fielddata cache clearing to these fields
+}
+
+// Segment represents a Lucene segment of a shard
+type Segment struct {
+	Generation    int64                  `json:"generation"`
+	NumDocs       int64                  `json:"num_docs"`
+	DeletedDocs   int64                  `json:"deleted_docs"`
+	SizeInBytes   int64                  `json:"size_in_bytes"`
+	MemoryInBytes int64                  `json:"memory_in_bytes"`
+	Committed     bool                   `json:"committed"`
+	Search        bool                   `json:"search"`
+	Version       string                 `json:"version"`
+	Compound      bool                   `json:"compound"`
+	Attributes    map[string]interface{} `json:"attributes,omitempty"`
+}
+
+// ShardSegments represents the segments of a shard copy
+type ShardSegments struct {
+	Routing struct {
+		State   string `json:"state"`
+		Primary bool   `json:"primary"`
+		Node    string `json:"node"`
+	} `json:"routing"`
+	NumCommittedSegments int                `json:"num_committed_segments"`
+	NumSearchSegments    int                `json:"num_search_segments"`
+	Segments             map[string]Segment `json:"segments"`
+}
+
+// SegmentsResult represents the segments of indices, by index name then shard number
+type SegmentsResult struct {
+	Shards  ShardsInfo `json:"_shards"`
+	Indices map[string]struct {
+		Shards map[string][]ShardSegments `json:"shards"`
+	} `json:"indices"`
+}
+
+func (c *client) ForceMerge(ctx context.Context, indices []string, maxNumSegments int, onlyExpungeDeletes bool, opts ...RequestOption) (*ForceMergeResponse, error) {
+	params := requestParams(opts)
+	if maxNumSegments > 0 {
+		params.Set("max_num_segments", strconv.Itoa(maxNumSegments))
+	}
+	if onlyExpungeDeletes {
+		params.Set("only_expunge_deletes", "true")
+	}
+
+	url := buildURL(c.Host.String()+indicesPath(indices)+"/_forcemerge", params)
+	response, err := sendHTTPRequestWithContext(ctx, "POST", url, nil, c.Timeout)
+	if err != nil {
+		return &ForceMergeResponse{}, err
+	}
+
+	esResp := &ForceMergeResponse{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &ForceMergeResponse{}, err
+	}
+
+	return esResp, nil
+}
+
+func (c *client) ClearCache(ctx context.Context, indices []string, options ClearCacheOptions) (*ShardsResponse, error) {
+	params := url.Values{}
+	if options.Fielddata {
+		params.Set("fielddata", "true")
+	}
+	if options.Query {
+		params.Set("query", "true")
+	}
+	if options.Request {
+		params.Set("request", "true")
+	}
+	if len(options.Fields) > 0 {
+		params.Set("fields", strings.Join(options.Fields, ","))
+	}
+
+	url := buildURL(c.Host.String()+indicesPath(indices)+"/_cache/clear", params)
+	response, err := sendHTTPRequestWithContext(ctx, "POST", url, nil, c.Timeout)
+	if err != nil {
+		return &ShardsResponse{}, err
+	}
+
+	esResp := &ShardsResponse{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &ShardsResponse{}, err
+	}
+
+	return esResp, nil
+}
+
+func (c *client) Segments(ctx context.Context, indices ...string) (*SegmentsResult, error) {
+	url := c.Host.String() + indicesPath(indices) + "/_segments"
+	response, err := sendHTTPRequestWithContext(ctx, "GET", url, nil, c.Timeout)
+	if err != nil {
+		return &SegmentsResult{}, err
+	}
+
+	esResp := &SegmentsResult{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &SegmentsResult{}, err
+	}
+
+	return esResp, nil
+}
@@ -0,0 +1,131 @@
+package elasticsearch_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/boes13/elasticsearch"
+)
+
+func TestRefreshAndFlush(t *testing.T) {
+	helper := Test{}
+	ctx := context.Background()
+
+	server := NewRecorder(Reply(http.StatusOK, `{"_shards": {"total": 4, "successful": 3, "failed": 1,
+		"failures": [{"index": "archive", "shard": 0, "status": "SERVICE_UNAVAILABLE"}]}}`))
+	defer server.Close()
+	client := server.Client()
+
+	refreshResponse, err := client.Refresh(ctx, "products", "archive")
+	helper.OK(t, err)
+	helper.Equals(t, 4, refreshResponse.Shards.Total)
+	helper.Equals(t, 3, refreshResponse.Shards.Successful)
+	helper.Equals(t, 1, len(refreshResponse.Shards.Failures))
+	helper.Equals(t, "archive", refreshResponse.Shards.Failures[0].Index)
+
+	flushResponse, err := client.Flush(ctx)
+	helper.OK(t, err)
+	helper.Equals(t, 1, flushResponse.Shards.Failed)
+
+	helper.Equals(t, []string{"POST /products,archive/_refresh", "POST /_flush"}, server.Requests)
+}
+
+func TestIndexActions(t *testing.T) {
+	helper := Test{}
+	ctx := context.Background()
+
+	server := NewRecorder(Reply(http.StatusOK, `{"acknowledged": true, "shards_acknowledged": true, "index": "products-resized"}`))
+	defer server.Close()
+	client := server.Client()
+
+	resize := elasticsearch.ResizeRequest{Settings: json.RawMessage(`{"index.number_of_shards": 1}`)}
+	response, err := client.ShrinkIndex(ctx, "products", "products-resized", resize, elasticsearch.WithWaitForActiveShards("1"))
+	helper.OK(t, err)
+	helper.Assert(t, response.Acknowledged && response.ShardsAcknowledged, "The shrink has not been acknowledged")
+	helper.Equals(t, "products-resized", response.Index)
+
+	_, err = client.SplitIndex(ctx, "products", "products-resized", elasticsearch.ResizeRequest{})
+	helper.OK(t, err)
+	_, err = client.CloneIndex(ctx, "products", "products-resized", elasticsearch.ResizeRequest{
+		Aliases: map[string]elasticsearch.AliasDefinition{"products-read": {}},
+	})
+	helper.OK(t, err)
+
+	response, err = client.FreezeIndex(ctx, "archive")
+	helper.OK(t, err)
+	helper.Assert(t, response.Acknowledged, "The freeze has not been acknowledged")
+	_, err = client.UnfreezeIndex(ctx, "archive")
+	helper.OK(t, err)
+
+	//Nothing is sent without an index
+	_, err = client.OpenIndex(ctx, nil)
+	helper.Equals(t, elasticsearch.ErrMissingIndex, err)
+	_, err = client.CloseIndex(ctx, []string{})
+	helper.Equals(t, elasticsearch.ErrMissingIndex, err)
+	_, err = client.FreezeIndex(ctx, "")
+	helper.Equals(t, elasticsearch.ErrMissingIndex, err)
+	_, err = client.CloneIndex(ctx, "products", "", elasticsearch.ResizeRequest{})
+	helper.Equals(t, elasticsearch.ErrMissingIndex, err)
+
+	helper.Equals(t, []string{
+		"POST /products/_shrink/products-resized?wait_for_active_shards=1",
+		"POST /products/_split/products-resized",
+		"POST /products/_clone/products-resized",
+		"POST /archive/_freeze",
+		"POST /archive/_unfreeze",
+	}, server.Requests)
+	helper.Equals(t, []string{
+		`{"settings":{"index.number_of_shards":1}}`,
+		`{}`,
+		`{"aliases":{"products-read":{}}}`,
+		"",
+		"",
+	}, server.Bodies)
+}
+
+func TestRolloverRequest(t *testing.T) {
+	helper := Test{}
+	ctx := context.Background()
+
+	server := NewRecorder(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /logs/_rollover":
+			w.Write([]byte(`{"acknowledged": false, "old_index": "logs-000001", "new_index": "logs-000002", "rolled_over": false, "dry_run": true,
+				"conditions": {"[max_docs: 1000]": true}}`))
+		case "POST /logs/_rollover/logs-custom":
+			w.Write([]byte(`{"acknowledged": true, "shards_acknowledged": true, "old_index": "logs-000001", "new_index": "logs-custom",
+				"rolled_over": true, "dry_run": false, "conditions": {"[max_docs: 1000]": true}}`))
+		case "GET /_alias/logs":
+			w.Write([]byte(`{"logs-custom": {"aliases": {"logs": {"is_write_index": true}}}, "logs-000001": {"aliases": {"logs": {"is_write_index": false}}}}`))
+		}
+	})
+	defer server.Close()
+	client := server.Client()
+
+	//Dry run
+	req := elasticsearch.RolloverRequest{Conditions: elasticsearch.RolloverConditions{MaxDocs: 1000}, DryRun: true}
+	response, err := client.Rollover(ctx, "logs", req)
+	helper.OK(t, err)
+	helper.Assert(t, !response.RolledOver && response.Conditions["[max_docs: 1000]"], "The dry run should only check the conditions")
+	helper.Assert(t, response.AliasIndices == nil, "The alias should not be read again after a dry run")
+
+	//Rollover to a named index
+	req.DryRun = false
+	req.NewIndex = "logs-custom"
+	response, err = client.Rollover(ctx, "logs", req, elasticsearch.WithWaitForActiveShards("1"))
+	helper.OK(t, err)
+	helper.Assert(t, response.RolledOver, "The alias has not been rolled over")
+	helper.Equals(t, []string{"logs-000001", "logs-custom"}, response.AliasIndices)
+
+	_, err = client.Rollover(ctx, "", req)
+	helper.Equals(t, elasticsearch.ErrMissingIndex, err)
+
+	helper.Equals(t, []string{
+		"POST /logs/_rollover?dry_run=true",
+		"POST /logs/_rollover/logs-custom?wait_for_active_shards=1",
+		"GET /_alias/logs",
+	}, server.Requests)
+	helper.Equals(t, `{"conditions":{"max_docs":1000}}`, server.Bodies[0])
+}
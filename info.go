@@ -0,0 +1,45 @@
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// DistributionOpenSearch is the distribution reported by OpenSearch clusters
+const DistributionOpenSearch = "opensearch"
+
+// IsOpenSearch reports whether the search engine is OpenSearch rather than Elasticsearch
+func (s *Status) IsOpenSearch() bool {
+	return s.Version.Distribution == DistributionOpenSearch
+}
+
+func (c *client) Info(ctx context.Context) (*Status, error) {
+	url := c.Host.String() + "/"
+	response, err := sendHTTPRequestWithContext(ctx, "GET", url, nil, c.Timeout)
+	if err != nil {
+		return &Status{}, err
+	}
+
+	esResp := &Status{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &Status{}, err
+	}
+
+	return esResp, nil
+}
+
+// isOpenSearch reports whether the cluster is OpenSearch, the distribution is only requested once per client
+func (c *client) isOpenSearch(ctx context.Context) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.info == nil {
+		info, err := c.Info(ctx)
+		if err != nil {
+			return false, err
+		}
+		c.info = info
+	}
+	return c.info.IsOpenSearch(), nil
+}
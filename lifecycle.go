@@ -0,0 +1,507 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+// Lifecycle phases, in the order an index goes through them
+const (
+	PhaseHot    = "hot"
+	PhaseWarm   = "warm"
+	PhaseCold   = "cold"
+	PhaseFrozen = "frozen"
+	PhaseDelete = "delete"
+)
+
+var lifecyclePhases = []string{PhaseHot, PhaseWarm, PhaseCold, PhaseFrozen, PhaseDelete}
+
+// ShrinkAction shrinks the index into a new index with fewer primary shards
+type ShrinkAction struct {
+	NumberOfShards int `json:"number_of_shards"`
+}
+
+// ForcemergeAction merges the segments of the index
+type ForcemergeAction struct {
+	MaxNumSegments int `json:"max_num_segments"`
+}
+
+// SetPriorityAction sets the recovery priority of the index
+type SetPriorityAction struct {
+	Priority int `json:"priority"`
+}
+
+// DeleteAction deletes the index
+type DeleteAction struct {
+	DeleteSearchableSnapshot *bool `json:"delete_searchable_snapshot,omitempty"`
+}
+
+// LifecycleActions represents the actions run in a lifecycle phase
+type LifecycleActions struct {
+	SetPriority *SetPriorityAction  `json:"set_priority,omitempty"`
+	Rollover    *RolloverConditions `json:"rollover,omitempty"`
+	Readonly    *struct{}           `json:"readonly,omitempty"`
+	Shrink      *ShrinkAction       `json:"shrink,omitempty"`
+	Forcemerge  *ForcemergeAction   `json:"forcemerge,omitempty"`
+	Delete      *DeleteAction       `json:"delete,omitempty"`
+}
+
+// LifecyclePhase represents a phase of a lifecycle policy, entered once the index is MinAge old
+type LifecyclePhase struct {
+	MinAge  string           `json:"min_age,omitempty"`
+	Actions LifecycleActions `json:"actions"`
+}
+
+// LifecyclePolicy represents a lifecycle policy, by phase name
+type LifecyclePolicy struct {
+	Phases map[string]LifecyclePhase `json:"phases"`
+	Meta   map[string]interface{}    `json:"_meta,omitempty"`
+}
+
+// LifecyclePolicyInfo represents a stored lifecycle policy.
+// SeqNo and PrimaryTerm are only set on OpenSearch, where they are needed to update the policy.
+type LifecyclePolicyInfo struct {
+	Version      int64           `json:"version"`
+	ModifiedDate string          `json:"modified_date"`
+	SeqNo        int64           `json:"-"`
+	PrimaryTerm  int64           `json:"-"`
+	Policy       LifecyclePolicy `json:"policy"`
+}
+
+// LifecycleExplain represents the current lifecycle state of an index.
+// On OpenSearch, Phase is the name of the ISM state.
+type LifecycleExplain struct {
+	Index      string          `json:"index"`
+	Managed    bool            `json:"managed"`
+	Policy     string          `json:"policy,omitempty"`
+	Age        string          `json:"age,omitempty"`
+	Phase      string          `json:"phase,omitempty"`
+	Action     string          `json:"action,omitempty"`
+	Step       string          `json:"step,omitempty"`
+	FailedStep string          `json:"failed_step,omitempty"`
+	StepInfo   json.RawMessage `json:"step_info,omitempty"`
+}
+
+// ismPolicy represents an OpenSearch Index State Management policy
+type ismPolicy struct {
+	Description  string     `json:"description"`
+	DefaultState string     `json:"default_state"`
+	States       []ismState `json:"states"`
+}
+
+type ismState struct {
+	Name        string          `json:"name"`
+	Actions     []ismAction     `json:"actions"`
+	Transitions []ismTransition `json:"transitions"`
+}
+
+type ismAction struct {
+	IndexPriority *SetPriorityAction `json:"index_priority,omitempty"`
+	Rollover      *struct {
+		MinIndexAge         string `json:"min_index_age,omitempty"`
+		MinDocCount         int64  `json:"min_doc_count,omitempty"`
+		MinSize             string `json:"min_size,omitempty"`
+		MinPrimaryShardSize string `json:"min_primary_shard_size,omitempty"`
+	} `json:"rollover,omitempty"`
+	ReadOnly *struct{} `json:"read_only,omitempty"`
+	Shrink   *struct {
+		NumNewShards int `json:"num_new_shards"`
+	} `json:"shrink,omitempty"`
+	ForceMerge *ForcemergeAction `json:"force_merge,omitempty"`
+	Delete     *struct{}         `json:"delete,omitempty"`
+}
+
+type ismTransition struct {
+	StateName  string `json:"state_name"`
+	Conditions *struct {
+		MinIndexAge string `json:"min_index_age"`
+	} `json:"conditions,omitempty"`
+}
+
+// toISM converts the policy into ISM states, one per phase, moving to the next phase once it is MinAge old
+func (p LifecyclePolicy) toISM() ismPolicy {
+	policy := ismPolicy{}
+	if description, ok := p.Meta["description"].(string); ok {
+		policy.Description = description
+	}
+
+	var phases []string
+	for _, name := range lifecyclePhases {
+		if _, ok := p.Phases[name]; ok {
+			phases = append(phases, name)
+		}
+	}
+
+	for i, name := range phases {
+		phase := p.Phases[name]
+		state := ismState{Name: name, Actions: []ismAction{}, Transitions: []ismTransition{}}
+		if phase.Actions.SetPriority != nil {
+			state.Actions = append(state.Actions, ismAction{IndexPriority: phase.Actions.SetPriority})
+		}
+		if rollover := phase.Actions.Rollover; rollover != nil {
+			action := ismAction{}
+			action.Rollover = &struct {
+				MinIndexAge         string `json:"min_index_age,omitempty"`
+				MinDocCount         int64  `json:"min_doc_count,omitempty"`
+				MinSize             string `json:"min_size,omitempty"`
+				MinPrimaryShardSize string `json:"min_primary_shard_size,omitempty"`
+			}{rollover.MaxAge, rollover.MaxDocs, rollover.MaxSize, rollover.MaxPrimaryShardSize}
+			state.Actions = append(state.Actions, action)
+		}
+		if phase.Actions.Readonly != nil {
+			state.Actions = append(state.Actions, ismAction{ReadOnly: &struct{}{}})
+		}
+		if phase.Actions.Shrink != nil {
+			action := ismAction{}
+			action.Shrink = &struct {
+				NumNewShards int `json:"num_new_shards"`
+			}{phase.Actions.Shrink.NumberOfShards}
+			state.Actions = append(state.Actions, action)
+		}
+		if phase.Actions.Forcemerge != nil {
+			state.Actions = append(state.Actions, ismAction{ForceMerge: phase.Actions.Forcemerge})
+		}
+		if phase.Actions.Delete != nil {
+			state.Actions = append(state.Actions, ismAction{Delete: &struct{}{}})
+		}
+
+		if i+1 < len(phases) {
+			transition := ismTransition{StateName: phases[i+1]}
+			if minAge := p.Phases[phases[i+1]].MinAge; minAge != "" {
+				transition.Conditions = &struct {
+					MinIndexAge string `json:"min_index_age"`
+				}{minAge}
+			}
+			state.Transitions = append(state.Transitions, transition)
+		}
+		policy.States = append(policy.States, state)
+	}
+
+	if len(phases) > 0 {
+		policy.DefaultState = phases[0]
+	}
+	return policy
+}
+
+// fromISM converts ISM states into phases, the minimum age of a phase being the one of the transition to it
+func (p ismPolicy) fromISM() LifecyclePolicy {
+	policy := LifecyclePolicy{Phases: make(map[string]LifecyclePhase, len(p.States))}
+	if p.Description != "" {
+		policy.Meta = map[string]interface{}{"description": p.Description}
+	}
+
+	minAges := map[string]string{}
+	for _, state := range p.States {
+		for _, transition := range state.Transitions {
+			if transition.Conditions != nil {
+				minAges[transition.StateName] = transition.Conditions.MinIndexAge
+			}
+		}
+	}
+
+	for _, state := range p.States {
+		phase := LifecyclePhase{MinAge: minAges[state.Name]}
+		for _, action := range state.Actions {
+			switch {
+			case action.IndexPriority != nil:
+				phase.Actions.SetPriority = action.IndexPriority
+			case action.Rollover != nil:
+				phase.Actions.Rollover = &RolloverConditions{
+					MaxAge:              action.Rollover.MinIndexAge,
+					MaxDocs:             action.Rollover.MinDocCount,
+					MaxSize:             action.Rollover.MinSize,
+					MaxPrimaryShardSize: action.Rollover.MinPrimaryShardSize,
+				}
+			case action.ReadOnly != nil:
+				phase.Actions.Readonly = &struct{}{}
+			case action.Shrink != nil:
+				phase.Actions.Shrink = &ShrinkAction{NumberOfShards: action.Shrink.NumNewShards}
+			case action.ForceMerge != nil:
+				phase.Actions.Forcemerge = action.ForceMerge
+			case action.Delete != nil:
+				phase.Actions.Delete = &DeleteAction{}
+			}
+		}
+		policy.Phases[state.Name] = phase
+	}
+	return policy
+}
+
+func (c *client) PutLifecyclePolicy(ctx context.Context, name string, policy LifecyclePolicy, opts ...RequestOption) (*Response, error) {
+	openSearch, err := c.isOpenSearch(ctx)
+	if err != nil {
+		return &Response{}, err
+	}
+
+	var body []byte
+	path := "/_ilm/policy/" + name
+	if openSearch {
+		path = "/_plugins/_ism/policies/" + name
+		body, err = json.Marshal(struct {
+			Policy ismPolicy `json:"policy"`
+		}{policy.toISM()})
+	} else {
+		body, err = json.Marshal(struct {
+			Policy LifecyclePolicy `json:"policy"`
+		}{policy})
+	}
+	if err != nil {
+		return &Response{}, err
+	}
+
+	url := buildURL(c.Host.String()+path, requestParams(opts))
+	response, err := sendHTTPRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(body), c.Timeout)
+	if err != nil {
+		return &Response{}, err
+	}
+
+	if openSearch {
+		// ISM answers with the stored policy rather than an acknowledgement
+		return &Response{Acknowledged: true}, nil
+	}
+
+	esResp := &Response{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &Response{}, err
+	}
+
+	return esResp, nil
+}
+
+func (c *client) GetLifecyclePolicy(ctx context.Context, name string) (*LifecyclePolicyInfo, error) {
+	if name == "" {
+		return &LifecyclePolicyInfo{}, ErrMissingName
+	}
+
+	openSearch, err := c.isOpenSearch(ctx)
+	if err != nil {
+		return &LifecyclePolicyInfo{}, err
+	}
+
+	url := c.Host.String() + "/_ilm/policy/" + name
+	if openSearch {
+		url = c.Host.String() + "/_plugins/_ism/policies/" + name
+	}
+	response, err := sendHTTPRequestWithContext(ctx, "GET", url, nil, c.Timeout)
+	if err != nil {
+		return &LifecyclePolicyInfo{}, err
+	}
+
+	if openSearch {
+		var esResp struct {
+			Version     int64     `json:"_version"`
+			SeqNo       int64     `json:"_seq_no"`
+			PrimaryTerm int64     `json:"_primary_term"`
+			Policy      ismPolicy `json:"policy"`
+		}
+		err = json.Unmarshal(response, &esResp)
+		if err != nil {
+			return &LifecyclePolicyInfo{}, err
+		}
+
+		return &LifecyclePolicyInfo{
+			Version:     esResp.Version,
+			SeqNo:       esResp.SeqNo,
+			PrimaryTerm: esResp.PrimaryTerm,
+			Policy:      esResp.Policy.fromISM(),
+		}, nil
+	}
+
+	esResp := map[string]LifecyclePolicyInfo{}
+	err = json.Unmarshal(response, &esResp)
+	if err != nil {
+		return &LifecyclePolicyInfo{}, err
+	}
+
+	info, ok := esResp[name]
+	if !ok {
+		return &LifecyclePolicyInfo{}, ErrNotFound
+	}
+	return &info, nil
+}
+
+func (c *client) DeleteLifecyclePolicy(ctx context.Context, name string) (*Response, error) {
+	openSearch, err := c.isOpenSearch(ctx)
+	if err != nil {
+		return &Response{}, err
+	}
+
+	url := c.Host.String() + "/_ilm/policy/" + name
+	if openSearch {
+		url = c.Host.String() + "/_plugins/_ism/policies/" + name
+	}
+	response, err := sendHTTPRequestWithContext(ctx, "DELETE", url, nil, c.Timeout)
+	if err != nil {
+		return &Response{}, err
+	}
+
+	if openSearch {
+		return &Response{Acknowledged: true}, nil
+	}
+
+	esResp := &Response{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &Response{}, err
+	}
+
+	return esResp, nil
+}
+
+func (c *client) ExplainLifecycle(ctx context.Context, indices ...string) (map[string]LifecycleExplain, error) {
+	if strings.Join(indices, "") == "" {
+		return nil, ErrMissingIndex
+	}
+
+	openSearch, err := c.isOpenSearch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if openSearch {
+		url := c.Host.String() + "/_plugins/_ism/explain/" + strings.Join(indices, ",")
+		response, err := sendHTTPRequestWithContext(ctx, "GET", url, nil, c.Timeout)
+		if err != nil {
+			return nil, err
+		}
+
+		var esResp map[string]json.RawMessage
+		err = json.Unmarshal(response, &esResp)
+		if err != nil {
+			return nil, err
+		}
+
+		explains := make(map[string]LifecycleExplain, len(esResp))
+		for index, data := range esResp {
+			if index == "total_managed_indices" {
+				continue
+			}
+
+			var state struct {
+				PolicyID string `json:"policy_id"`
+				State    *struct {
+					Name string `json:"name"`
+				} `json:"state"`
+				Action *struct {
+					Name   string `json:"name"`
+					Failed bool   `json:"failed"`
+				} `json:"action"`
+				Step *struct {
+					Name string `json:"name"`
+				} `json:"step"`
+				Info json.RawMessage `json:"info"`
+			}
+			err = json.Unmarshal(data, &state)
+			if err != nil {
+				return nil, err
+			}
+
+			explain := LifecycleExplain{Index: index, Managed: state.PolicyID != "", Policy: state.PolicyID, StepInfo: state.Info}
+			if state.State != nil {
+				explain.Phase = state.State.Name
+			}
+			if state.Step != nil {
+				explain.Step = state.Step.Name
+			}
+			if state.Action != nil {
+				explain.Action = state.Action.Name
+				if state.Action.Failed {
+					explain.FailedStep = explain.Step
+				}
+			}
+			explains[index] = explain
+		}
+		return explains, nil
+	}
+
+	url := c.Host.String() + "/" + strings.Join(indices, ",") + "/_ilm/explain"
+	response, err := sendHTTPRequestWithContext(ctx, "GET", url, nil, c.Timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	var esResp struct {
+		Indices map[string]LifecycleExplain `json:"indices"`
+	}
+	err = json.Unmarshal(response, &esResp)
+	if err != nil {
+		return nil, err
+	}
+
+	return esResp.Indices, nil
+}
+
+// lifecycleAction runs an ILM action on the cluster, unsupported on OpenSearch
+func (c *client) lifecycleAction(ctx context.Context, action string) (*Response, error) {
+	openSearch, err := c.isOpenSearch(ctx)
+	if err != nil {
+		return &Response{}, err
+	}
+	if openSearch {
+		return &Response{}, ErrUnsupported
+	}
+
+	url := c.Host.String() + "/_ilm/" + action
+	response, err := sendHTTPRequestWithContext(ctx, "POST", url, nil, c.Timeout)
+	if err != nil {
+		return &Response{}, err
+	}
+
+	esResp := &Response{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &Response{}, err
+	}
+
+	return esResp, nil
+}
+
+func (c *client) StartLifecycle(ctx context.Context) (*Response, error) {
+	return c.lifecycleAction(ctx, "start")
+}
+
+func (c *client) StopLifecycle(ctx context.Context) (*Response, error) {
+	return c.lifecycleAction(ctx, "stop")
+}
+
+func (c *client) RetryLifecycle(ctx context.Context, indices ...string) (*Response, error) {
+	if strings.Join(indices, "") == "" {
+		return &Response{}, ErrMissingIndex
+	}
+
+	openSearch, err := c.isOpenSearch(ctx)
+	if err != nil {
+		return &Response{}, err
+	}
+
+	url := c.Host.String() + "/" + strings.Join(indices, ",") + "/_ilm/retry"
+	if openSearch {
+		url = c.Host.String() + "/_plugins/_ism/retry/" + strings.Join(indices, ",")
+	}
+	response, err := sendHTTPRequestWithContext(ctx, "POST", url, nil, c.Timeout)
+	if err != nil {
+		return &Response{}, err
+	}
+
+	if openSearch {
+		var esResp struct {
+			Failures bool `json:"failures"`
+		}
+		err = json.Unmarshal(response, &esResp)
+		if err != nil {
+			return &Response{}, err
+		}
+		return &Response{Acknowledged: !esResp.Failures}, nil
+	}
+
+	esResp := &Response{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &Response{}, err
+	}
+
+	return esResp, nil
+}
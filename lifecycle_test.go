@@ -0,0 +1,176 @@
+package elasticsearch_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/boes13/elasticsearch"
+)
+
+func TestOpenSearchLifecycle(t *testing.T) {
+	helper := Test{}
+	ctx := context.Background()
+
+	//OpenSearch cluster storing a single ISM policy
+	var stored json.RawMessage
+	server := NewRecorder(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /":
+			w.Write([]byte(`{"name": "node-1", "version": {"distribution": "opensearch", "number": "2.11.0"}}`))
+		case "PUT /_plugins/_ism/policies/logs":
+			if stored != nil {
+				w.WriteHeader(http.StatusConflict)
+				w.Write([]byte(`{"error": {"type": "version_conflict_engine_exception"}, "status": 409}`))
+				return
+			}
+			var body struct {
+				Policy json.RawMessage `json:"policy"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			stored = body.Policy
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"_id": "logs", "_version": 1, "_seq_no": 0, "_primary_term": 1}`))
+		case "GET /_plugins/_ism/policies/logs":
+			w.Write([]byte(`{"_id": "logs", "_version": 1, "_seq_no": 0, "_primary_term": 1, "policy": ` + string(stored) + `}`))
+		case "GET /_plugins/_ism/explain/logs-000001":
+			w.Write([]byte(`{"logs-000001": {"index": "logs-000001", "policy_id": "logs", "state": {"name": "hot"}, "action": {"name": "rollover", "failed": true}, "step": {"name": "attempt_rollover"}, "info": {"message": "Missing rollover_alias"}}, "total_managed_indices": 1}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+	client := server.Client()
+
+	policy := elasticsearch.LifecyclePolicy{
+		Phases: map[string]elasticsearch.LifecyclePhase{
+			elasticsearch.PhaseHot:    {Actions: elasticsearch.LifecycleActions{Rollover: &elasticsearch.RolloverConditions{MaxAge: "1d", MaxSize: "50gb"}}},
+			elasticsearch.PhaseWarm:   {MinAge: "7d", Actions: elasticsearch.LifecycleActions{Forcemerge: &elasticsearch.ForcemergeAction{MaxNumSegments: 1}}},
+			elasticsearch.PhaseDelete: {MinAge: "30d", Actions: elasticsearch.LifecycleActions{Delete: &elasticsearch.DeleteAction{}}},
+		},
+		Meta: map[string]interface{}{"description": "Logs retention"},
+	}
+
+	//Put
+	response, err := client.PutLifecyclePolicy(ctx, "logs", policy)
+	helper.OK(t, err)
+	helper.Assert(t, response.Acknowledged, "The policy has not been created")
+	helper.Equals(t, `{"description":"Logs retention","default_state":"hot","states":[`+
+		`{"name":"hot","actions":[{"rollover":{"min_index_age":"1d","min_size":"50gb"}}],"transitions":[{"state_name":"warm","conditions":{"min_index_age":"7d"}}]},`+
+		`{"name":"warm","actions":[{"force_merge":{"max_num_segments":1}}],"transitions":[{"state_name":"delete","conditions":{"min_index_age":"30d"}}]},`+
+		`{"name":"delete","actions":[{"delete":{}}],"transitions":[]}]}`, string(stored))
+
+	//Put again without the sequence number of the stored policy
+	_, err = client.PutLifecyclePolicy(ctx, "logs", policy)
+	helper.Assert(t, errors.Is(err, elasticsearch.ErrVersionConflict), "The rejected update should be reported, got %v", err)
+
+	//Get
+	info, err := client.GetLifecyclePolicy(ctx, "logs")
+	helper.OK(t, err)
+	helper.Equals(t, policy, info.Policy)
+	helper.Equals(t, int64(1), info.PrimaryTerm)
+
+	_, err = client.GetLifecyclePolicy(ctx, "missing")
+	helper.Assert(t, errors.Is(err, elasticsearch.ErrNotFound), "A missing policy should return ErrNotFound")
+
+	//Explain
+	explains, err := client.ExplainLifecycle(ctx, "logs-000001")
+	helper.OK(t, err)
+	helper.Equals(t, 1, len(explains))
+	explain := explains["logs-000001"]
+	helper.Assert(t, explain.Managed && explain.Policy == "logs", "The index should be managed by the policy")
+	helper.Equals(t, elasticsearch.PhaseHot, explain.Phase)
+	helper.Equals(t, "attempt_rollover", explain.FailedStep)
+
+	//Start is not supported by ISM
+	_, err = client.StartLifecycle(ctx)
+	helper.Equals(t, elasticsearch.ErrUnsupported, err)
+}
+
+func TestElasticsearchLifecycle(t *testing.T) {
+	helper := Test{}
+	ctx := context.Background()
+
+	server := NewRecorder(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /":
+			w.Write([]byte(`{"name": "node-1", "version": {"number": "8.11.0"}}`))
+		case "PUT /_ilm/policy/logs", "POST /_ilm/start", "POST /_ilm/stop":
+			w.Write([]byte(`{"acknowledged": true}`))
+		case "GET /_ilm/policy/logs,metrics":
+			w.Write([]byte(`{"logs": {"version": 2, "policy": {"phases": {}}}, "metrics": {"version": 1, "policy": {"phases": {}}}}`))
+		case "GET /_ilm/policy/logs":
+			w.Write([]byte(`{"logs": {"version": 2, "modified_date": "2024-01-01T00:00:00.000Z", "policy": {"phases": {
+				"hot": {"min_age": "0ms", "actions": {"rollover": {"max_age": "1d"}}}
+			}}}}`))
+		case "GET /logs-000001/_ilm/explain":
+			w.Write([]byte(`{"indices": {"logs-000001": {"index": "logs-000001", "managed": true, "policy": "logs", "phase": "hot",
+				"action": "rollover", "step": "ERROR", "failed_step": "check-rollover-ready", "step_info": {"type": "illegal_argument_exception"}}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": {"type": "resource_not_found_exception"}, "status": 404}`))
+		}
+	})
+	defer server.Close()
+	client := server.Client()
+
+	policy := elasticsearch.LifecyclePolicy{
+		Phases: map[string]elasticsearch.LifecyclePhase{
+			elasticsearch.PhaseHot: {MinAge: "0ms", Actions: elasticsearch.LifecycleActions{Rollover: &elasticsearch.RolloverConditions{MaxAge: "1d"}}},
+		},
+	}
+
+	//Put
+	response, err := client.PutLifecyclePolicy(ctx, "logs", policy)
+	helper.OK(t, err)
+	helper.Assert(t, response.Acknowledged, "The policy has not been created")
+
+	//Get
+	info, err := client.GetLifecyclePolicy(ctx, "logs")
+	helper.OK(t, err)
+	helper.Equals(t, int64(2), info.Version)
+	helper.Equals(t, policy, info.Policy)
+
+	_, err = client.GetLifecyclePolicy(ctx, "missing")
+	helper.Assert(t, errors.Is(err, elasticsearch.ErrNotFound), "A missing policy should return ErrNotFound")
+	_, err = client.GetLifecyclePolicy(ctx, "logs,metrics")
+	helper.Assert(t, errors.Is(err, elasticsearch.ErrNotFound), "A name absent from the response should return ErrNotFound")
+	_, err = client.GetLifecyclePolicy(ctx, "")
+	helper.Equals(t, elasticsearch.ErrMissingName, err)
+
+	//Explain
+	explains, err := client.ExplainLifecycle(ctx, "logs-000001")
+	helper.OK(t, err)
+	explain := explains["logs-000001"]
+	helper.Assert(t, explain.Managed && explain.Policy == "logs", "The index should be managed by the policy")
+	helper.Equals(t, "check-rollover-ready", explain.FailedStep)
+	helper.Equals(t, `{"type": "illegal_argument_exception"}`, string(explain.StepInfo))
+	_, err = client.ExplainLifecycle(ctx)
+	helper.Equals(t, elasticsearch.ErrMissingIndex, err)
+
+	//Retry
+	_, err = client.RetryLifecycle(ctx, "")
+	helper.Equals(t, elasticsearch.ErrMissingIndex, err)
+
+	//Stop and start
+	response, err = client.StopLifecycle(ctx)
+	helper.OK(t, err)
+	helper.Assert(t, response.Acknowledged, "ILM has not been stopped")
+	response, err = client.StartLifecycle(ctx)
+	helper.OK(t, err)
+	helper.Assert(t, response.Acknowledged, "ILM has not been started")
+
+	helper.Equals(t, []string{
+		"GET /",
+		"PUT /_ilm/policy/logs",
+		"GET /_ilm/policy/logs",
+		"GET /_ilm/policy/missing",
+		"GET /_ilm/policy/logs,metrics",
+		"GET /logs-000001/_ilm/explain",
+		"POST /_ilm/stop",
+		"POST /_ilm/start",
+	}, server.Requests)
+	helper.Equals(t, `{"policy":{"phases":{"hot":{"min_age":"0ms","actions":{"rollover":{"max_age":"1d"}}}}}}`, server.Bodies[1])
+}
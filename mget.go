@@ -0,0 +1,85 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+)
+
+// SourceFilter selects the fields of _source to return
+type SourceFilter struct {
+	Includes []string `json:"includes,omitempty"`
+	Excludes []string `json:"excludes,omitempty"`
+}
+
+// MultiGetItem represents a document to retrieve in a multi get operation
+type MultiGetItem struct {
+	Index        string        `json:"_index"`
+	ID           string        `json:"_id"`
+	Routing      string        `json:"routing,omitempty"`
+	Source       *SourceFilter `json:"_source,omitempty"`
+	StoredFields []string      `json:"stored_fields,omitempty"`
+}
+
+// MultiGetDocument represents a document retrieved by a multi get operation.
+// Error is set when the document could not be retrieved, Found is false when it does not exist.
+type MultiGetDocument struct {
+	Document
+	Error *ErrorCause `json:"error,omitempty"`
+}
+
+// MultiGetResult represents the result of a multi get operation, in the order of the request items
+type MultiGetResult struct {
+	Docs []MultiGetDocument `json:"docs"`
+}
+
+// TypedDocument represents a document retrieved by a multi get operation with its _source decoded
+type TypedDocument[T any] struct {
+	MultiGetDocument
+	Value T
+}
+
+func (c *client) MultiGet(ctx context.Context, items []MultiGetItem, opts ...RequestOption) (*MultiGetResult, error) {
+	body, err := json.Marshal(struct {
+		Docs []MultiGetItem `json:"docs"`
+	}{items})
+	if err != nil {
+		return &MultiGetResult{}, err
+	}
+
+	url := buildURL(c.Host.String()+"/_mget", requestParams(opts))
+	response, err := sendHTTPRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body), c.Timeout)
+	if err != nil {
+		return &MultiGetResult{}, err
+	}
+
+	esResp := &MultiGetResult{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &MultiGetResult{}, err
+	}
+
+	return esResp, nil
+}
+
+// MultiGetTyped runs a multi get operation and decodes the _source of each found document into T.
+// Documents are returned in the order of the request items.
+func MultiGetTyped[T any](ctx context.Context, c Client, items []MultiGetItem, opts ...RequestOption) ([]TypedDocument[T], error) {
+	result, err := c.MultiGet(ctx, items, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	docs := make([]TypedDocument[T], len(result.Docs))
+	for i, doc := range result.Docs {
+		docs[i].MultiGetDocument = doc
+		if doc.Found && len(doc.Source) > 0 {
+			err = json.Unmarshal(doc.Source, &docs[i].Value)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return docs, nil
+}
@@ -0,0 +1,80 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// multiSearchHeader represents the header line of a search in a multi search body
+type multiSearchHeader struct {
+	Index                     []string `json:"index,omitempty"`
+	Routing                   string   `json:"routing,omitempty"`
+	Preference                string   `json:"preference,omitempty"`
+	SearchType                string   `json:"search_type,omitempty"`
+	RequestCache              *bool    `json:"request_cache,omitempty"`
+	AllowPartialSearchResults *bool    `json:"allow_partial_search_results,omitempty"`
+}
+
+// MultiSearchItemResult represents the result of one search of a multi search operation.
+// Error is set when this search failed, the other searches are not affected.
+type MultiSearchItemResult struct {
+	SearchResult
+	Status int         `json:"status"`
+	Error  *ErrorCause `json:"error,omitempty"`
+}
+
+// MultiSearchResult represents the result of a multi search operation, in the order of the requests
+type MultiSearchResult struct {
+	Took      uint64                  `json:"took"`
+	Responses []MultiSearchItemResult `json:"responses"`
+}
+
+// WithMaxConcurrentSearches sets the maximum number of searches of a multi search run concurrently
+func WithMaxConcurrentSearches(max int) RequestOption {
+	return func(params url.Values) {
+		params.Set("max_concurrent_searches", strconv.Itoa(max))
+	}
+}
+
+func (c *client) MultiSearch(ctx context.Context, requests []SearchRequest, opts ...RequestOption) (*MultiSearchResult, error) {
+	buffer := new(bytes.Buffer)
+	encoder := json.NewEncoder(buffer)
+	for _, req := range requests {
+		header := multiSearchHeader{
+			Index:                     req.Indices,
+			Routing:                   strings.Join(req.Routing, ","),
+			Preference:                req.Preference,
+			SearchType:                req.SearchType,
+			RequestCache:              req.RequestCache,
+			AllowPartialSearchResults: req.AllowPartialSearchResults,
+		}
+
+		// The encoder writes each value on a single line followed by \n, as expected by the NDJSON body
+		err := encoder.Encode(header)
+		if err != nil {
+			return &MultiSearchResult{}, err
+		}
+		err = encoder.Encode(req)
+		if err != nil {
+			return &MultiSearchResult{}, err
+		}
+	}
+
+	url := buildURL(c.Host.String()+"/_msearch", requestParams(opts))
+	response, err := sendHTTPRequestWithContext(ctx, "POST", url, buffer, c.Timeout)
+	if err != nil {
+		return &MultiSearchResult{}, err
+	}
+
+	esResp := &MultiSearchResult{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &MultiSearchResult{}, err
+	}
+
+	return esResp, nil
+}
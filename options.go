@@ -0,0 +1,120 @@
+package elasticsearch
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// RequestOption sets an optional URL parameter of a request.
+// Every document and search method accepts options, e.g. WithRouting to target the shard of a tenant.
+type RequestOption func(params url.Values)
+
+// requestParams applies the options to a new set of URL parameters
+func requestParams(opts []RequestOption) url.Values {
+	params := url.Values{}
+	for _, opt := range opts {
+		opt(params)
+	}
+	return params
+}
+
+// WithWaitForCompletion sets whether the operation waits for its completion. When it does not, the response
+// holds a task id to follow its progress with GetTask.
+func WithWaitForCompletion(wait bool) RequestOption {
+	return func(params url.Values) {
+		params.Set("wait_for_completion", strconv.FormatBool(wait))
+	}
+}
+
+// WithIfSeqNo only performs the write if the document has this sequence number.
+// It must be used along with WithIfPrimaryTerm.
+func WithIfSeqNo(seqNo int64) RequestOption {
+	return func(params url.Values) {
+		params.Set("if_seq_no", strconv.FormatInt(seqNo, 10))
+	}
+}
+
+// WithIfPrimaryTerm only performs the write if the document has this primary term
+func WithIfPrimaryTerm(primaryTerm int64) RequestOption {
+	return func(params url.Values) {
+		params.Set("if_primary_term", strconv.FormatInt(primaryTerm, 10))
+	}
+}
+
+// WithVersion sets the expected version of the document.
+// On clusters older than 6.7 this is the way to get optimistic concurrency control.
+func WithVersion(version int64) RequestOption {
+	return func(params url.Values) {
+		params.Set("version", strconv.FormatInt(version, 10))
+	}
+}
+
+// WithVersionType sets how the version is checked: internal, external or external_gte
+func WithVersionType(versionType string) RequestOption {
+	return func(params url.Values) {
+		params.Set("version_type", versionType)
+	}
+}
+
+// WithSourceIncludes only returns these fields of _source
+func WithSourceIncludes(fields ...string) RequestOption {
+	return func(params url.Values) {
+		params.Set("_source_includes", strings.Join(fields, ","))
+	}
+}
+
+// WithSourceExcludes removes these fields from the returned _source
+func WithSourceExcludes(fields ...string) RequestOption {
+	return func(params url.Values) {
+		params.Set("_source_excludes", strings.Join(fields, ","))
+	}
+}
+
+// WithStoredFields returns these stored fields instead of _source
+func WithStoredFields(fields ...string) RequestOption {
+	return func(params url.Values) {
+		params.Set("stored_fields", strings.Join(fields, ","))
+	}
+}
+
+// WithRouting sets the routing values used to target the shards.
+// A document written with a routing value must be read and deleted with the same value.
+func WithRouting(routing ...string) RequestOption {
+	return func(params url.Values) {
+		params.Set("routing", strings.Join(routing, ","))
+	}
+}
+
+// WithPreference sets the nodes or shards the operation is performed on, e.g. _local
+func WithPreference(preference string) RequestOption {
+	return func(params url.Values) {
+		params.Set("preference", preference)
+	}
+}
+
+// WithRealtime sets whether a get is realtime or only sees refreshed documents
+func WithRealtime(realtime bool) RequestOption {
+	return func(params url.Values) {
+		params.Set("realtime", strconv.FormatBool(realtime))
+	}
+}
+
+// RefreshPolicy represents when the changes made by a write become visible to search
+type RefreshPolicy string
+
+// Refresh policies of write operations
+const (
+	RefreshTrue    RefreshPolicy = "true"
+	RefreshFalse   RefreshPolicy = "false"
+	RefreshWaitFor RefreshPolicy = "wait_for"
+)
+
+// WithRefresh sets when the changes made by a write become visible to search.
+// On a get, RefreshTrue refreshes the relevant shard before reading the document.
+// Reindex, update by query and delete by query only accept RefreshTrue and RefreshFalse.
+func WithRefresh(policy RefreshPolicy) RequestOption {
+	return func(params url.Values) {
+		params.Set("refresh", string(policy))
+	}
+}
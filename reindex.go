@@ -0,0 +1,75 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// ReindexSource represents the documents to copy in a reindex operation
+type ReindexSource struct {
+	Index []string        `json:"index"`
+	Query json.RawMessage `json:"query,omitempty"`
+	Size  int             `json:"size,omitempty"` // batch size of the underlying scroll
+}
+
+// ReindexDestination represents the index the documents are copied to in a reindex operation
+type ReindexDestination struct {
+	Index       string `json:"index"`
+	Pipeline    string `json:"pipeline,omitempty"`
+	OpType      string `json:"op_type,omitempty"`
+	VersionType string `json:"version_type,omitempty"`
+}
+
+// BulkByScrollOptions represents the options shared by reindex, update by query and delete by query
+// operations. They are sent as URL parameters.
+type BulkByScrollOptions struct {
+	Slices            string  // number of slices or "auto"
+	RequestsPerSecond float64 // -1 disables throttling
+}
+
+// ReindexRequest represents a reindex operation
+type ReindexRequest struct {
+	Source    ReindexSource      `json:"source"`
+	Dest      ReindexDestination `json:"dest"`
+	Script    *Script            `json:"script,omitempty"`
+	Conflicts string             `json:"conflicts,omitempty"` // abort or proceed
+	MaxDocs   int64              `json:"max_docs,omitempty"`
+
+	BulkByScrollOptions `json:"-"`
+}
+
+func (c *client) Reindex(ctx context.Context, req ReindexRequest, opts ...RequestOption) (*BulkByScrollResponse, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return &BulkByScrollResponse{}, err
+	}
+
+	url := buildURL(c.Host.String()+"/_reindex", bulkByScrollParams(req.BulkByScrollOptions, opts))
+	response, err := sendHTTPRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body), c.Timeout)
+	if err != nil {
+		return &BulkByScrollResponse{}, err
+	}
+
+	esResp := &BulkByScrollResponse{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &BulkByScrollResponse{}, err
+	}
+
+	return esResp, nil
+}
+
+// bulkByScrollParams returns the URL parameters shared by reindex, update by query and delete by query
+func bulkByScrollParams(options BulkByScrollOptions, opts []RequestOption) url.Values {
+	params := requestParams(opts)
+	if options.Slices != "" {
+		params.Set("slices", options.Slices)
+	}
+	if options.RequestsPerSecond != 0 {
+		params.Set("requests_per_second", strconv.FormatFloat(options.RequestsPerSecond, 'f', -1, 64))
+	}
+	return params
+}
@@ -0,0 +1,51 @@
+package elasticsearch_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/boes13/elasticsearch"
+)
+
+func TestReindex(t *testing.T) {
+	type Product struct {
+		Name string
+	}
+
+	helper := Test{}
+	ctx := context.Background()
+	client := elasticsearch.NewClient(ESScheme, ESHost, ESPort)
+	client.SetHttpTimeout(5 * time.Second)
+	client.CreateIndex(IndexName, IndexMapping)
+
+	jsonProduct, err := json.Marshal(Product{Name: "Jeans"})
+	helper.OK(t, err)
+	_, err = client.InsertDocument(IndexName, ProductDocumentType, "1", jsonProduct, elasticsearch.WithRefresh(elasticsearch.RefreshTrue))
+	helper.OK(t, err)
+
+	//Synchronous reindex
+	req := elasticsearch.ReindexRequest{}
+	req.Source.Index = []string{IndexName}
+	req.Dest.Index = IndexName + "_copy"
+	reindexResponse, err := client.Reindex(ctx, req, elasticsearch.WithRefresh(elasticsearch.RefreshTrue))
+	helper.OK(t, err)
+	helper.Assert(t, reindexResponse.Created == 1, "The document has not been reindexed")
+
+	//Asynchronous reindex
+	req.RequestsPerSecond = 100
+	reindexResponse, err = client.Reindex(ctx, req, elasticsearch.WithWaitForCompletion(false))
+	helper.OK(t, err)
+	helper.Assert(t, reindexResponse.Task != "", "The reindex has not returned a task id")
+
+	task, err := client.GetTask(ctx, reindexResponse.Task)
+	helper.OK(t, err)
+	helper.Assert(t, task.Task.Action != "", "The task has not been retrieved")
+
+	//Delete the indices
+	client.DeleteIndex(IndexName + "_copy")
+	deleteResponse, err := client.DeleteIndex(IndexName)
+	helper.OK(t, err)
+	helper.Assert(t, deleteResponse.Acknowledged, "Index has not been deleted")
+}
@@ -10,15 +10,16 @@ import (
 	"net/http"
 )
 
-func (c *client) Search(indexName, documentType, data string, explain bool) (*SearchResult, error) {
+func (c *client) Search(indexName, documentType, data string, explain bool, opts ...RequestOption) (*SearchResult, error) {
 	if len(documentType) > 0 {
 		documentType = documentType + "/"
 	}
 
-	url := c.Host.String() + "/" + indexName + "/" + documentType + "/_search"
+	params := requestParams(opts)
 	if explain {
-		url += "?explain"
+		params.Set("explain", "true")
 	}
+	url := buildURL(c.Host.String()+"/"+indexName+"/"+documentType+"/_search", params)
 	reader := bytes.NewBufferString(data)
 	response, err := sendHTTPRequest("POST", url, reader, c.Timeout)
 	if err != nil {
@@ -38,7 +39,13 @@ func (c *client) MSearch(queries []MSearchQuery) (*MSearchResult, error) {
 	replacer := strings.NewReplacer("\n", " ")
 	queriesList := make([]string, len(queries))
 	for i, query := range queries {
-		queriesList[i] = query.Header + "\n" + replacer.Replace(query.Body)
+		// Compacting only removes the whitespace between tokens, string literals are left untouched
+		body := new(bytes.Buffer)
+		if err := json.Compact(body, []byte(query.Body)); err == nil {
+			queriesList[i] = query.Header + "\n" + body.String()
+		} else {
+			queriesList[i] = query.Header + "\n" + replacer.Replace(query.Body)
+		}
 	}
 
 	mSearchQuery := strings.Join(queriesList, "\n") + "\n" // Don't forget trailing \n
@@ -59,8 +66,8 @@ func (c *client) MSearch(queries []MSearchQuery) (*MSearchResult, error) {
 	return esResp, nil
 }
 
-func (c *client) Suggest(indexName, data string) ([]byte, error) {
-	url := c.Host.String() + "/" + indexName + "/_suggest"
+func (c *client) Suggest(indexName, data string, opts ...RequestOption) ([]byte, error) {
+	url := buildURL(c.Host.String()+"/"+indexName+"/_suggest", requestParams(opts))
 	reader := bytes.NewBufferString(data)
 	response, err := sendHTTPRequest("POST", url, reader, c.Timeout)
 	return response, err
@@ -70,24 +77,23 @@ type Scroller struct {
 	ScrollId string `json:"_scroll_id"`
 	Took     uint64 `json:"took"`
 	TimedOut bool   `json:"timed_out"`
-	Shards   struct {
-		Total      int `json:"total"`
-		Successful int `json:"successful"`
-		Failed     int `json:"failed"`
-	} `json:"_shards"`
+	Shards   ShardsInfo `json:"_shards"`
 	Hits    ResultHits `json:"hits"`
 	baseUrl string
 	expire  string
 	httpTimeout time.Duration
 }
 
-func (c *client) SearchByScanAndScroll(indexName string, documentType string, expireTime time.Duration, body string) (*Scroller, error) {
+func (c *client) SearchByScanAndScroll(indexName string, documentType string, expireTime time.Duration, body string, opts ...RequestOption) (*Scroller, error) {
 	// parameter validation
 	if indexName == "" || documentType == "" || expireTime.Nanoseconds() == 0 {
 		return nil, errors.New("Either indexName, documentType, or expirationTime parameter is invalid!")
 	}
 	expire := fmt.Sprintf("%ds", int(expireTime.Seconds()))
-	url := fmt.Sprintf("%s/%s/%s/_search?search_type=scan&scroll=%s", c.Host.String(), indexName, documentType, expire)
+	params := requestParams(opts)
+	params.Set("search_type", "scan")
+	params.Set("scroll", expire)
+	url := buildURL(fmt.Sprintf("%s/%s/%s/_search", c.Host.String(), indexName, documentType), params)
 	reader := bytes.NewBufferString(body)
 	response, err := sendHTTPRequest(http.MethodPost, url, reader, c.Timeout)
 	if err != nil {
@@ -0,0 +1,112 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// Search types
+const (
+	SearchTypeQueryThenFetch    = "query_then_fetch"
+	SearchTypeDfsQueryThenFetch = "dfs_query_then_fetch"
+)
+
+// Sort orders
+const (
+	SortAsc  = "asc"
+	SortDesc = "desc"
+)
+
+// Sort represents a sort criterion of a search request
+type Sort struct {
+	Field        string
+	Order        string      // SortAsc or SortDesc
+	Missing      interface{} // _last, _first or a custom value
+	Mode         string      // min, max, sum, avg or median for multi-valued fields
+	UnmappedType string
+}
+
+// MarshalJSON encodes the criterion as a field name when no option is set, as a field object otherwise
+func (s Sort) MarshalJSON() ([]byte, error) {
+	if s.Order == "" && s.Missing == nil && s.Mode == "" && s.UnmappedType == "" {
+		return json.Marshal(s.Field)
+	}
+
+	return json.Marshal(map[string]interface{}{
+		s.Field: struct {
+			Order        string      `json:"order,omitempty"`
+			Missing      interface{} `json:"missing,omitempty"`
+			Mode         string      `json:"mode,omitempty"`
+			UnmappedType string      `json:"unmapped_type,omitempty"`
+		}{s.Order, s.Missing, s.Mode, s.UnmappedType},
+	})
+}
+
+// SearchRequest represents a search operation on one or several indices, wildcards allowed.
+// Indices, Routing, Preference, SearchType, RequestCache and AllowPartialSearchResults are sent as URL parameters,
+// the other fields in the body.
+type SearchRequest struct {
+	Indices []string `json:"-"` // all the indices when empty
+
+	Query          json.RawMessage      `json:"query,omitempty"`
+	PostFilter     json.RawMessage      `json:"post_filter,omitempty"`
+	Aggregations   json.RawMessage      `json:"aggs,omitempty"`
+	From           int                  `json:"from,omitempty"`
+	Size           *int                 `json:"size,omitempty"` // 10 by the search engine when nil
+	Sort           []Sort               `json:"sort,omitempty"`
+	Source         interface{}          `json:"_source,omitempty"` // true, false, a list of fields or a SourceFilter
+	StoredFields   []string             `json:"stored_fields,omitempty"`
+	TrackTotalHits interface{}          `json:"track_total_hits,omitempty"` // true, false or the number of hits to count accurately
+	Timeout        string               `json:"timeout,omitempty"`
+	TerminateAfter int                  `json:"terminate_after,omitempty"`
+	Explain        bool                 `json:"explain,omitempty"`
+	Highlight      *Highlight           `json:"highlight,omitempty"`
+	Suggest        map[string]Suggester `json:"suggest,omitempty"`
+
+	Routing                   []string `json:"-"`
+	Preference                string   `json:"-"`
+	SearchType                string   `json:"-"` // SearchTypeQueryThenFetch or SearchTypeDfsQueryThenFetch
+	RequestCache              *bool    `json:"-"`
+	AllowPartialSearchResults *bool    `json:"-"`
+}
+
+func (c *client) SearchWithRequest(ctx context.Context, req SearchRequest, opts ...RequestOption) (*SearchResult, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return &SearchResult{}, err
+	}
+
+	params := requestParams(opts)
+	if len(req.Routing) > 0 {
+		params.Set("routing", strings.Join(req.Routing, ","))
+	}
+	if req.Preference != "" {
+		params.Set("preference", req.Preference)
+	}
+	if req.SearchType != "" {
+		params.Set("search_type", req.SearchType)
+	}
+	if req.RequestCache != nil {
+		params.Set("request_cache", strconv.FormatBool(*req.RequestCache))
+	}
+	if req.AllowPartialSearchResults != nil {
+		params.Set("allow_partial_search_results", strconv.FormatBool(*req.AllowPartialSearchResults))
+	}
+
+	url := buildURL(c.Host.String()+indicesPath(req.Indices)+"/_search", params)
+	response, err := sendHTTPRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body), c.Timeout)
+	if err != nil {
+		return &SearchResult{}, err
+	}
+
+	esResp := &SearchResult{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &SearchResult{}, err
+	}
+
+	return esResp, nil
+}
@@ -0,0 +1,342 @@
+package elasticsearch_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/boes13/elasticsearch"
+)
+
+func TestSearchRequestBody(t *testing.T) {
+	helper := Test{}
+	size := 0
+	req := elasticsearch.SearchRequest{
+		Indices:        []string{"products-*"},
+		Query:          json.RawMessage(`{"match_all":{}}`),
+		From:           20,
+		Size:           &size,
+		Sort:           []elasticsearch.Sort{{Field: "price", Order: elasticsearch.SortDesc, Missing: "_last"}, {Field: "_score"}},
+		Source:         elasticsearch.SourceFilter{Includes: []string{"name"}},
+		TrackTotalHits: true,
+		SearchType:     elasticsearch.SearchTypeDfsQueryThenFetch,
+	}
+
+	body, err := json.Marshal(req)
+	helper.OK(t, err)
+	helper.Equals(t, `{"query":{"match_all":{}},"from":20,"size":0,"sort":[{"price":{"order":"desc","missing":"_last"}},"_score"],"_source":{"includes":["name"]},"track_total_hits":true}`, string(body))
+}
+
+func TestSearchWithRequest(t *testing.T) {
+	helper := Test{}
+	ctx := context.Background()
+	server := NewRecorder(Reply(http.StatusOK, `{"took": 3, "hits": {"total": {"value": 1, "relation": "eq"}, "hits": [{"_index": "products-2024", "_id": "1", "_source": {"name": "Jeans"}}]}}`))
+	defer server.Close()
+	client := server.Client()
+
+	requestCache := false
+	allowPartial := true
+	result, err := client.SearchWithRequest(ctx, elasticsearch.SearchRequest{
+		Indices:                   []string{"products-*", "archive"},
+		Query:                     json.RawMessage(`{"match_all":{}}`),
+		Routing:                   []string{"user1", "user2"},
+		Preference:                "_local",
+		SearchType:                elasticsearch.SearchTypeDfsQueryThenFetch,
+		RequestCache:              &requestCache,
+		AllowPartialSearchResults: &allowPartial,
+	}, elasticsearch.WithSourceIncludes("name"))
+	helper.OK(t, err)
+	helper.Equals(t, int64(1), result.Hits.Total.Value)
+	helper.Equals(t, "products-2024", result.Hits.Hits[0].Index)
+
+	//All the indices without any parameter
+	_, err = client.SearchWithRequest(ctx, elasticsearch.SearchRequest{})
+	helper.OK(t, err)
+
+	helper.Equals(t, []string{
+		"POST /products-*,archive/_search?_source_includes=name&allow_partial_search_results=true&preference=_local&request_cache=false&routing=user1%2Cuser2&search_type=dfs_query_then_fetch",
+		"POST /_search",
+	}, server.Requests)
+	helper.Equals(t, []string{`{"query":{"match_all":{}}}`, `{}`}, server.Bodies)
+}
+
+func TestSearchResultDecoding(t *testing.T) {
+	helper := Test{}
+
+	//Elasticsearch < 7
+	var legacy elasticsearch.SearchResult
+	err := json.Unmarshal([]byte(`{"hits":{"total":2,"max_score":1.0,"hits":[]}}`), &legacy)
+	helper.OK(t, err)
+	helper.Equals(t, elasticsearch.TotalHits{Value: 2, Relation: elasticsearch.TotalHitsEqual}, legacy.Hits.Total)
+
+	//Elasticsearch >= 7
+	var result elasticsearch.SearchResult
+	err = json.Unmarshal([]byte(`{
+		"_shards": {"total": 2, "successful": 1, "skipped": 0, "failed": 1, "failures": [
+			{"shard": 1, "index": "products", "node": "n1", "reason": {"type": "query_shard_exception", "reason": "failed to create query"}}
+		]},
+		"hits": {
+			"total": {"value": 10000, "relation": "gte"},
+			"max_score": null,
+			"hits": [{
+				"_index": "products", "_id": "1", "_score": null, "_routing": "tenant1", "_seq_no": 3, "_primary_term": 1,
+				"_source": {"Name": "Jeans"},
+				"sort": [12.5, "jeans"],
+				"matched_queries": ["by_name"],
+				"_explanation": {"value": 1.5, "description": "sum of:", "details": [{"value": 1.5, "description": "weight(Name:jeans)"}]},
+				"inner_hits": {"variants": {"hits": {"total": {"value": 1, "relation": "eq"}, "hits": [
+					{"_index": "products", "_id": "1", "_nested": {"field": "variants", "offset": 0}, "_source": {"Color": "blue"}}
+				]}}}
+			}]
+		}
+	}`), &result)
+	helper.OK(t, err)
+	helper.Equals(t, elasticsearch.TotalHits{Value: 10000, Relation: elasticsearch.TotalHitsLowerBound}, result.Hits.Total)
+	helper.Equals(t, "query_shard_exception", result.Shards.Failures[0].Reason.Type)
+
+	hit := result.Hits.Hits[0]
+	helper.Equals(t, "tenant1", hit.Routing)
+	helper.Equals(t, int64(3), hit.SeqNo)
+	helper.Equals(t, []interface{}{12.5, "jeans"}, hit.Sort)
+	helper.Equals(t, []string{"by_name"}, hit.MatchedQueries)
+	helper.Equals(t, "weight(Name:jeans)", hit.Explanation.Details[0].Description)
+
+	inner := hit.InnerHits["variants"].Hits
+	helper.Equals(t, int64(1), inner.Total.Value)
+	helper.Equals(t, &elasticsearch.NestedIdentity{Field: "variants", Offset: 0}, inner.Hits[0].Nested)
+}
+
+func TestHighlightedSource(t *testing.T) {
+	type Brand struct {
+		Name string
+	}
+	type Product struct {
+		Name  string
+		Tags  []string
+		Brand Brand
+		Price int
+		SKU   int64
+	}
+
+	helper := Test{}
+	hit := elasticsearch.Hit{
+		Source: json.RawMessage(`{"Name":"Blue jeans","Tags":["denim","blue","slim fit denim"],"Brand":{"Name":"Levi's"},"Price":50,"SKU":9007199254740993}`),
+		Highlight: map[string][]string{
+			"Name":         {"Blue <em>jeans</em>"},
+			"Tags":         {"<em>denim</em>", "<em>slim</em> fit"},
+			"Brand.Name":   {"<em>Levi's</em>"},
+			"Missing.Name": {"ignored"},
+		},
+	}
+
+	var p Product
+	err := hit.HighlightedSource(&p, " ... ")
+	helper.OK(t, err)
+	helper.Equals(t, Product{
+		Name:  "Blue <em>jeans</em>",
+		Tags:  []string{"<em>denim</em>", "blue", "<em>slim</em> fit denim"},
+		Brand: Brand{Name: "<em>Levi's</em>"},
+		Price: 50,
+		SKU:   9007199254740993,
+	}, p)
+}
+
+func TestSuggestionDecoding(t *testing.T) {
+	helper := Test{}
+	var result elasticsearch.SearchResult
+	err := json.Unmarshal([]byte(`{"suggest": {
+		"spelling": [{"text": "jaens", "offset": 0, "length": 5, "options": [{"text": "jeans", "score": 0.8, "freq": 12}]}],
+		"autocomplete": [{"text": "lev", "offset": 0, "length": 3, "options": [
+			{"text": "Levi's jeans", "_index": "products", "_id": "1", "_score": 2.5, "_source": {"Name": "Levi's jeans"}, "contexts": {"category": ["pants"]}}
+		]}]
+	}}`), &result)
+	helper.OK(t, err)
+
+	spelling := result.Suggest["spelling"][0].Options[0]
+	helper.Equals(t, 0.8, spelling.Score)
+	helper.Equals(t, 12, spelling.Freq)
+
+	completion := result.Suggest["autocomplete"][0].Options[0]
+	helper.Equals(t, 2.5, completion.Score)
+	helper.Equals(t, "1", completion.ID)
+	helper.Equals(t, []string{"pants"}, completion.Contexts["category"])
+}
+
+func TestSuggestions(t *testing.T) {
+	helper := Test{}
+	server := NewRecorder(Reply(http.StatusOK, `{"hits": {"total": {"value": 0, "relation": "eq"}, "hits": []}, "suggest": {
+		"spelling": [{"text": "jaens", "offset": 0, "length": 5, "options": [{"text": "jeans", "score": 0.8, "freq": 12}]}],
+		"autocomplete": [{"text": "lev", "offset": 0, "length": 3, "options": [{"text": "Levi's", "_index": "products", "_id": "1", "_score": 2.5}]}]
+	}}`))
+	defer server.Close()
+	client := server.Client()
+
+	prefixLength := 1
+	suggestions, err := client.Suggestions(context.Background(), []string{"products"}, map[string]elasticsearch.Suggester{
+		"spelling": {
+			Text: "jaens",
+			Term: &elasticsearch.TermSuggester{Field: "Name", Size: 3, SuggestMode: "popular", PrefixLength: &prefixLength},
+		},
+		"autocomplete": {
+			Prefix: "lev",
+			Completion: &elasticsearch.CompletionSuggester{
+				Field:          "Suggest",
+				Size:           5,
+				SkipDuplicates: true,
+				Fuzzy:          &elasticsearch.Fuzzy{Fuzziness: "AUTO", MinLength: 3},
+				Contexts: map[string][]elasticsearch.CompletionContext{
+					"category": {{Context: "pants", Boost: 2}, {Context: "sho", Prefix: true}},
+				},
+			},
+		},
+	})
+	helper.OK(t, err)
+	helper.Equals(t, "jeans", suggestions["spelling"][0].Options[0].Text)
+	helper.Equals(t, "1", suggestions["autocomplete"][0].Options[0].ID)
+
+	helper.Equals(t, []string{"POST /products/_search"}, server.Requests)
+	helper.Equals(t, `{"size":0,"suggest":{`+
+		`"autocomplete":{"prefix":"lev","completion":{"field":"Suggest","size":5,"skip_duplicates":true,"fuzzy":{"fuzziness":"AUTO","min_length":3},`+
+		`"contexts":{"category":[{"context":"pants","boost":2},{"context":"sho","prefix":true}]}}},`+
+		`"spelling":{"text":"jaens","term":{"field":"Name","size":3,"suggest_mode":"popular","prefix_length":1}}}}`, server.Bodies[0])
+}
+
+func TestMultiSearch(t *testing.T) {
+	helper := Test{}
+	ctx := context.Background()
+	client := elasticsearch.NewClient(ESScheme, ESHost, ESPort)
+	client.SetHttpTimeout(5 * time.Second)
+	client.CreateIndex(IndexName, IndexMapping)
+
+	_, err := client.Bulk([]byte(`{"index":{"_index":"`+IndexName+`","_id":"1"}}
+{"Name":"Jeans\nBlue"}
+{"index":{"_index":"`+IndexName+`","_id":"2"}}
+{"Name":"Polo"}
+`), elasticsearch.WithRefresh(elasticsearch.RefreshWaitFor))
+	helper.OK(t, err)
+
+	one := 1
+	requests := []elasticsearch.SearchRequest{
+		{Indices: []string{IndexName}, Query: json.RawMessage(`{"match": {"Name": "jeans"}}`)},
+		{Indices: []string{IndexName}, Query: json.RawMessage(`{"match_all": {}}`), Size: &one, Preference: "_local"},
+		{Indices: []string{IndexName + "_missing"}, Query: json.RawMessage(`{"match_all": {}}`)},
+	}
+	result, err := client.MultiSearch(ctx, requests, elasticsearch.WithMaxConcurrentSearches(2))
+	helper.OK(t, err)
+	helper.Equals(t, 3, len(result.Responses))
+	helper.Assert(t, result.Responses[0].Hits.Total.Value == 1, "The multi search doesn't return all matched items")
+	helper.Assert(t, len(result.Responses[1].Hits.Hits) == 1, "The multi search doesn't apply the size")
+	helper.Assert(t, result.Responses[2].Error != nil, "The failed search has no error")
+	helper.Equals(t, "index_not_found_exception", result.Responses[2].Error.Type)
+	helper.Equals(t, http.StatusNotFound, result.Responses[2].Status)
+
+	//Delete the index
+	deleteResponse, err := client.DeleteIndex(IndexName)
+	helper.OK(t, err)
+	helper.Assert(t, deleteResponse.Acknowledged, "Index has not been deleted")
+}
+
+func TestMultiSearchRequest(t *testing.T) {
+	helper := Test{}
+	server := NewRecorder(Reply(http.StatusOK, `{"took": 4, "responses": [
+		{"status": 200, "hits": {"total": {"value": 1, "relation": "eq"}, "hits": [{"_index": "products", "_id": "1"}]}},
+		{"status": 404, "error": {"type": "index_not_found_exception", "reason": "no such index [missing]", "index": "missing"}}
+	]}`))
+	defer server.Close()
+	client := server.Client()
+
+	one := 1
+	result, err := client.MultiSearch(context.Background(), []elasticsearch.SearchRequest{
+		{Indices: []string{"products"}, Query: json.RawMessage(`{"match": {"Name": "jeans"}}`), Routing: []string{"user1", "user2"}, Preference: "_local"},
+		{Indices: []string{"missing"}, Size: &one, SearchType: elasticsearch.SearchTypeDfsQueryThenFetch},
+	}, elasticsearch.WithMaxConcurrentSearches(2))
+	helper.OK(t, err)
+	helper.Equals(t, 2, len(result.Responses))
+	helper.Equals(t, http.StatusOK, result.Responses[0].Status)
+	helper.Equals(t, "1", result.Responses[0].Hits.Hits[0].ID)
+	helper.Equals(t, http.StatusNotFound, result.Responses[1].Status)
+	helper.Equals(t, "missing", result.Responses[1].Error.Index)
+
+	helper.Equals(t, []string{"POST /_msearch?max_concurrent_searches=2"}, server.Requests)
+	helper.Equals(t, `{"index":["products"],"routing":"user1,user2","preference":"_local"}
+{"query":{"match":{"Name":"jeans"}}}
+{"index":["missing"],"search_type":"dfs_query_then_fetch"}
+{"size":1}
+`, server.Bodies[0])
+}
+
+func TestExplainAndValidateQuery(t *testing.T) {
+	helper := Test{}
+	ctx := context.Background()
+	client := elasticsearch.NewClient(ESScheme, ESHost, ESPort)
+	client.SetHttpTimeout(5 * time.Second)
+	client.CreateIndex(IndexName, IndexMapping)
+
+	_, err := client.CreateDocument(ctx, IndexName, "1", []byte(`{"Name":"Blue jeans"}`), elasticsearch.WithRefresh(elasticsearch.RefreshWaitFor))
+	helper.OK(t, err)
+
+	//Explain
+	explain, err := client.Explain(ctx, IndexName, "1", json.RawMessage(`{"match": {"Name": "jeans"}}`))
+	helper.OK(t, err)
+	helper.Assert(t, explain.Matched, "The document should match the query")
+	helper.Assert(t, explain.Explanation.Value > 0, "The explanation has no score")
+
+	//Validate
+	validate, err := client.ValidateQuery(ctx, IndexName, json.RawMessage(`{"match": {"Name": "jeans"}}`), false, true)
+	helper.OK(t, err)
+	helper.Assert(t, validate.Valid, "The query should be valid")
+	helper.Assert(t, validate.Explanations[0].Explanation != "", "The rewritten query has not been returned")
+
+	validate, err = client.ValidateQuery(ctx, IndexName, json.RawMessage(`{"range": {"Name": {"unknown": 1}}}`), true, false)
+	helper.OK(t, err)
+	helper.Assert(t, !validate.Valid, "The query should be invalid")
+
+	//Delete the index
+	deleteResponse, err := client.DeleteIndex(IndexName)
+	helper.OK(t, err)
+	helper.Assert(t, deleteResponse.Acknowledged, "Index has not been deleted")
+}
+
+func TestFieldCaps(t *testing.T) {
+	helper := Test{}
+	ctx := context.Background()
+	server := NewRecorder(Reply(http.StatusOK, `{"indices": ["logs-1"], "fields": {
+		"status": {"keyword": {"type": "keyword", "searchable": true, "aggregatable": true}}
+	}}`))
+	defer server.Close()
+	client := server.Client()
+
+	result, err := client.FieldCaps(ctx, []string{"logs-*", "archive"}, "status", "user.*")
+	helper.OK(t, err)
+	helper.Equals(t, []string{"logs-1"}, result.Indices)
+	helper.Assert(t, result.Fields["status"]["keyword"].Aggregatable, "The capabilities have not been decoded")
+
+	//All the fields of all the indices
+	_, err = client.FieldCaps(ctx, nil)
+	helper.OK(t, err)
+
+	helper.Equals(t, []string{
+		"GET /logs-*,archive/_field_caps?fields=status%2Cuser.%2A",
+		"GET /_field_caps?fields=%2A",
+	}, server.Requests)
+}
+
+func TestFieldCapsConflicts(t *testing.T) {
+	helper := Test{}
+	var result elasticsearch.FieldCapsResult
+	err := json.Unmarshal([]byte(`{
+		"indices": ["logs-1", "logs-2"],
+		"fields": {
+			"message": {"text": {"type": "text", "searchable": true, "aggregatable": false}},
+			"status": {
+				"keyword": {"type": "keyword", "searchable": true, "aggregatable": true, "indices": ["logs-1"]},
+				"long": {"type": "long", "searchable": true, "aggregatable": true, "indices": ["logs-2"]}
+			}
+		}
+	}`), &result)
+	helper.OK(t, err)
+	helper.Assert(t, result.Fields["status"]["long"].Aggregatable, "The capabilities have not been decoded")
+	helper.Equals(t, map[string][]string{"status": {"keyword", "long"}}, result.Conflicts())
+}
@@ -2,13 +2,37 @@ package elasticsearch
 
 import "encoding/json"
 
-// Response represents a boolean response sent back by the search engine
+// Response represents a boolean response sent back by the search engine.
+// Error and Status are not filled on failure anymore, a *RequestError is returned instead.
 type Response struct {
 	Acknowledged bool
 	Error        string
 	Status       int
 }
 
+// ShardsInfo represents the number of shards an operation has been performed on
+type ShardsInfo struct {
+	Total      int            `json:"total"`
+	Successful int            `json:"successful"`
+	Skipped    int            `json:"skipped"`
+	Failed     int            `json:"failed"`
+	Failures   []ShardFailure `json:"failures,omitempty"`
+}
+
+// ShardFailure represents the failure of an operation on a shard
+type ShardFailure struct {
+	Shard  int        `json:"shard"`
+	Index  string     `json:"index"`
+	Node   string     `json:"node"`
+	Status string     `json:"status,omitempty"`
+	Reason ErrorCause `json:"reason"`
+}
+
+// ShardsResponse represents a response sent back by the search engine which only holds shards information
+type ShardsResponse struct {
+	Shards ShardsInfo `json:"_shards"`
+}
+
 // Settings represents the mapping structure of one or several indices
 type Settings struct {
 	Shards  map[string]interface{} `json:"_shards"`
@@ -17,9 +41,11 @@ type Settings struct {
 
 // Status represents the status of the search engine
 type Status struct {
-	TagLine string
-	Version struct {
+	TagLine     string
+	ClusterName string `json:"cluster_name"`
+	Version     struct {
 		Number         string
+		Distribution   string `json:"distribution"` // opensearch for OpenSearch, empty for Elasticsearch
 		BuildHash      string `json:"build_hash"`
 		BuildTimestamp string `json:"build_timestamp"`
 		BuildSnapshot  bool   `json:"build_snapshot"`
@@ -32,21 +58,27 @@ type Status struct {
 
 // InsertDocument represents the result of the insert operation of a document
 type InsertDocument struct {
-	Created bool   `json:"created"`
-	Index   string `json:"_index"`
-	Type    string `json:"_type"`
-	ID      string `json:"_id"`
-	Version int    `json:"_version"`
+	Created     bool   `json:"created"`
+	Index       string `json:"_index"`
+	Type        string `json:"_type"`
+	ID          string `json:"_id"`
+	Version     int    `json:"_version"`
+	SeqNo       int64  `json:"_seq_no"`
+	PrimaryTerm int64  `json:"_primary_term"`
+	Result      string `json:"result"`
 }
 
 // Document represents a document
 type Document struct {
-	Index   string          `json:"_index"`
-	Type    string          `json:"_type"`
-	ID      string          `json:"_id"`
-	Version int             `json:"_version"`
-	Found   bool            `json:"found"`
-	Source  json.RawMessage `json:"_source"`
+	Index       string                 `json:"_index"`
+	Type        string                 `json:"_type"`
+	ID          string                 `json:"_id"`
+	Version     int                    `json:"_version"`
+	SeqNo       int64                  `json:"_seq_no"`
+	PrimaryTerm int64                  `json:"_primary_term"`
+	Found       bool                   `json:"found"`
+	Source      json.RawMessage        `json:"_source"`
+	Fields      map[string]interface{} `json:"fields,omitempty"`
 }
 
 // Bulk represents the result of the Bulk operation
@@ -110,29 +142,82 @@ type ActionUpdate struct {
 
 // SearchResult represents the result of the search operation
 type SearchResult struct {
-	Took     uint64 `json:"took"`
-	TimedOut bool   `json:"timed_out"`
-	Shards   struct {
-		Total      int `json:"total"`
-		Successful int `json:"successful"`
-		Failed     int `json:"failed"`
-	} `json:"_shards"`
-	Hits         ResultHits      `json:"hits"`
-	Aggregations json.RawMessage `json:"aggregations"`
+	Took         uint64                  `json:"took"`
+	TimedOut     bool                    `json:"timed_out"`
+	Shards       ShardsInfo              `json:"_shards"`
+	Hits         ResultHits              `json:"hits"`
+	Aggregations json.RawMessage         `json:"aggregations"`
+	Suggest      map[string][]Suggestion `json:"suggest,omitempty"`
+}
+
+// Relations of the total number of hits to the actual number of matching documents
+const (
+	TotalHitsEqual      = "eq"
+	TotalHitsLowerBound = "gte"
+)
+
+// TotalHits represents the number of hits matching a search query.
+// Relation is TotalHitsLowerBound when the search engine stopped counting before the end.
+type TotalHits struct {
+	Value    int64  `json:"value"`
+	Relation string `json:"relation"`
+}
+
+// UnmarshalJSON decodes both the number sent back before Elasticsearch 7 and the object sent since
+func (t *TotalHits) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '{' {
+		type totalHits TotalHits
+		return json.Unmarshal(data, (*totalHits)(t))
+	}
+
+	t.Relation = TotalHitsEqual
+	return json.Unmarshal(data, &t.Value)
 }
 
 // ResultHits represents the result of the search hits
 type ResultHits struct {
-	Total    int     `json:"total"`
-	MaxScore float32 `json:"max_score"`
-	Hits     []struct {
-		Index     string              `json:"_index"`
-		Type      string              `json:"_type"`
-		ID        string              `json:"_id"`
-		Score     float32             `json:"_score"`
-		Source    json.RawMessage     `json:"_source"`
-		Highlight map[string][]string `json:"highlight,omitempty"`
-	} `json:"hits"`
+	Total    TotalHits `json:"total"`
+	MaxScore float32   `json:"max_score"`
+	Hits     []Hit     `json:"hits"`
+}
+
+// Hit represents a document matching a search query
+type Hit struct {
+	Index          string                 `json:"_index"`
+	Type           string                 `json:"_type"`
+	ID             string                 `json:"_id"`
+	Score          float32                `json:"_score"`
+	Routing        string                 `json:"_routing,omitempty"`
+	Version        int64                  `json:"_version,omitempty"`
+	SeqNo          int64                  `json:"_seq_no,omitempty"`
+	PrimaryTerm    int64                  `json:"_primary_term,omitempty"`
+	Nested         *NestedIdentity        `json:"_nested,omitempty"`
+	Source         json.RawMessage        `json:"_source"`
+	Fields         map[string]interface{} `json:"fields,omitempty"`
+	Highlight      map[string][]string    `json:"highlight,omitempty"`
+	Sort           []interface{}          `json:"sort,omitempty"`
+	MatchedQueries []string               `json:"matched_queries,omitempty"`
+	Explanation    *Explanation           `json:"_explanation,omitempty"`
+	InnerHits      map[string]InnerHits   `json:"inner_hits,omitempty"`
+}
+
+// NestedIdentity represents the position of a nested document within its parent document
+type NestedIdentity struct {
+	Field  string          `json:"field"`
+	Offset int             `json:"offset"`
+	Nested *NestedIdentity `json:"_nested,omitempty"`
+}
+
+// InnerHits represents the hits of an inner_hits section
+type InnerHits struct {
+	Hits ResultHits `json:"hits"`
+}
+
+// Explanation represents how the score of a document has been computed
+type Explanation struct {
+	Value       float64       `json:"value"`
+	Description string        `json:"description"`
+	Details     []Explanation `json:"details,omitempty"`
 }
 
 // MSearchQuery Multi Search query
@@ -145,3 +230,31 @@ type MSearchQuery struct {
 type MSearchResult struct {
 	Responses []SearchResult `json:"responses"`
 }
+
+// Script represents a script executed by the search engine, painless by default
+type Script struct {
+	Source string                 `json:"source,omitempty"`
+	ID     string                 `json:"id,omitempty"`
+	Lang   string                 `json:"lang,omitempty"`
+	Params map[string]interface{} `json:"params,omitempty"`
+}
+
+// ErrorCause represents an error reported by the search engine
+type ErrorCause struct {
+	Type      string       `json:"type"`
+	Reason    string       `json:"reason"`
+	Index     string       `json:"index,omitempty"`
+	Shard     interface{}  `json:"shard,omitempty"`
+	CausedBy  *ErrorCause  `json:"caused_by,omitempty"`
+	RootCause []ErrorCause `json:"root_cause,omitempty"`
+}
+
+// AliasDefinition represents the settings of an alias on an index
+type AliasDefinition struct {
+	Filter        json.RawMessage `json:"filter,omitempty"`
+	Routing       string          `json:"routing,omitempty"`
+	IndexRouting  string          `json:"index_routing,omitempty"`
+	SearchRouting string          `json:"search_routing,omitempty"`
+	IsWriteIndex  *bool           `json:"is_write_index,omitempty"`
+	IsHidden      *bool           `json:"is_hidden,omitempty"`
+}
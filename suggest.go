@@ -0,0 +1,128 @@
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Suggester represents a named suggestion request: the input text and the suggester to run on it.
+// Exactly one of Term, Phrase and Completion must be set.
+type Suggester struct {
+	Text       string               `json:"text,omitempty"`
+	Prefix     string               `json:"prefix,omitempty"` // completion suggester input
+	Regex      string               `json:"regex,omitempty"`  // completion suggester input
+	Term       *TermSuggester       `json:"term,omitempty"`
+	Phrase     *PhraseSuggester     `json:"phrase,omitempty"`
+	Completion *CompletionSuggester `json:"completion,omitempty"`
+}
+
+// TermSuggester suggests terms based on edit distance
+type TermSuggester struct {
+	Field          string `json:"field"`
+	Size           int    `json:"size,omitempty"`
+	SuggestMode    string `json:"suggest_mode,omitempty"` // missing, popular or always
+	Sort           string `json:"sort,omitempty"`         // score or frequency
+	MaxEdits       int    `json:"max_edits,omitempty"`
+	PrefixLength   *int   `json:"prefix_length,omitempty"`
+	MinWordLength  int    `json:"min_word_length,omitempty"`
+	StringDistance string `json:"string_distance,omitempty"`
+}
+
+// PhraseSuggester suggests whole corrected phrases
+type PhraseSuggester struct {
+	Field                   string   `json:"field"`
+	Size                    int      `json:"size,omitempty"`
+	GramSize                int      `json:"gram_size,omitempty"`
+	RealWordErrorLikelihood float64  `json:"real_word_error_likelihood,omitempty"`
+	Confidence              *float64 `json:"confidence,omitempty"`
+	MaxErrors               float64  `json:"max_errors,omitempty"`
+	Separator               string   `json:"separator,omitempty"`
+	Highlight               *struct {
+		PreTag  string `json:"pre_tag"`
+		PostTag string `json:"post_tag"`
+	} `json:"highlight,omitempty"`
+	Collate *struct {
+		Query json.RawMessage `json:"query"`
+		Prune bool            `json:"prune,omitempty"`
+	} `json:"collate,omitempty"`
+}
+
+// CompletionSuggester suggests documents from a completion field, as you type
+type CompletionSuggester struct {
+	Field          string                         `json:"field"`
+	Size           int                            `json:"size,omitempty"`
+	SkipDuplicates bool                           `json:"skip_duplicates,omitempty"`
+	Fuzzy          *Fuzzy                         `json:"fuzzy,omitempty"`
+	Contexts       map[string][]CompletionContext `json:"contexts,omitempty"`
+}
+
+// Fuzzy represents the fuzziness settings of a completion suggester
+type Fuzzy struct {
+	Fuzziness      interface{} `json:"fuzziness,omitempty"` // AUTO or a maximum edit distance
+	Transpositions *bool       `json:"transpositions,omitempty"`
+	MinLength      int         `json:"min_length,omitempty"`
+	PrefixLength   int         `json:"prefix_length,omitempty"`
+	UnicodeAware   bool        `json:"unicode_aware,omitempty"`
+}
+
+// CompletionContext filters or boosts the suggestions of a context enabled completion field.
+// Context is a category name or, for geo contexts, a location.
+type CompletionContext struct {
+	Context   interface{} `json:"context"`
+	Boost     float64     `json:"boost,omitempty"`
+	Prefix    bool        `json:"prefix,omitempty"`
+	Precision interface{} `json:"precision,omitempty"`
+}
+
+// Suggestion represents the suggestions made for one token, or for the whole text, of a suggestion request
+type Suggestion struct {
+	Text    string             `json:"text"`
+	Offset  int                `json:"offset"`
+	Length  int                `json:"length"`
+	Options []SuggestionOption `json:"options"`
+}
+
+// SuggestionOption represents a suggestion.
+// Index, ID, Source, Payload and Contexts are only set by the completion suggester.
+type SuggestionOption struct {
+	Text         string              `json:"text"`
+	Score        float64             `json:"score"`
+	Freq         int                 `json:"freq,omitempty"`
+	Highlighted  string              `json:"highlighted,omitempty"`
+	CollateMatch bool                `json:"collate_match,omitempty"`
+	Index        string              `json:"_index,omitempty"`
+	Type         string              `json:"_type,omitempty"`
+	ID           string              `json:"_id,omitempty"`
+	Source       json.RawMessage     `json:"_source,omitempty"`
+	Payload      json.RawMessage     `json:"payload,omitempty"`
+	Contexts     map[string][]string `json:"contexts,omitempty"`
+}
+
+// UnmarshalJSON decodes the score of the completion suggester, sent as _score since Elasticsearch 6
+func (o *SuggestionOption) UnmarshalJSON(data []byte) error {
+	type suggestionOption SuggestionOption
+	option := struct {
+		*suggestionOption
+		DocScore *float64 `json:"_score"`
+	}{suggestionOption: (*suggestionOption)(o)}
+
+	err := json.Unmarshal(data, &option)
+	if err != nil {
+		return err
+	}
+
+	if option.DocScore != nil {
+		o.Score = *option.DocScore
+	}
+	return nil
+}
+
+func (c *client) Suggestions(ctx context.Context, indices []string, suggesters map[string]Suggester, opts ...RequestOption) (map[string][]Suggestion, error) {
+	size := 0
+	result, err := c.SearchWithRequest(ctx, SearchRequest{Indices: indices, Size: &size, Suggest: suggesters}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Suggest, nil
+}
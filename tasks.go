@@ -0,0 +1,141 @@
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// BulkByScrollFailure represents a document which could not be processed by a reindex,
+// update by query or delete by query operation
+type BulkByScrollFailure struct {
+	Index  string     `json:"index"`
+	Type   string     `json:"type"`
+	ID     string     `json:"id"`
+	Status int        `json:"status"`
+	Cause  ErrorCause `json:"cause"`
+}
+
+// BulkByScrollStatus represents the progress of a reindex, update by query or delete by query operation
+type BulkByScrollStatus struct {
+	Total            int64 `json:"total"`
+	Created          int64 `json:"created"`
+	Updated          int64 `json:"updated"`
+	Deleted          int64 `json:"deleted"`
+	Batches          int64 `json:"batches"`
+	VersionConflicts int64 `json:"version_conflicts"`
+	Noops            int64 `json:"noops"`
+	Retries          struct {
+		Bulk   int64 `json:"bulk"`
+		Search int64 `json:"search"`
+	} `json:"retries"`
+	ThrottledMillis      int64   `json:"throttled_millis"`
+	RequestsPerSecond    float64 `json:"requests_per_second"`
+	ThrottledUntilMillis int64   `json:"throttled_until_millis"`
+}
+
+// BulkByScrollResponse represents the result of a reindex, update by query or delete by query operation.
+// When the operation runs asynchronously only Task is set.
+type BulkByScrollResponse struct {
+	BulkByScrollStatus
+	Task     string                `json:"task"`
+	Took     uint64                `json:"took"`
+	TimedOut bool                  `json:"timed_out"`
+	Failures []BulkByScrollFailure `json:"failures"`
+}
+
+// TaskInfo represents a task running on the cluster.
+// The content of Status depends on the action of the task, see BulkByScrollStatus.
+type TaskInfo struct {
+	Node               string          `json:"node"`
+	ID                 int64           `json:"id"`
+	Type               string          `json:"type"`
+	Action             string          `json:"action"`
+	Description        string          `json:"description"`
+	StartTimeInMillis  int64           `json:"start_time_in_millis"`
+	RunningTimeInNanos int64           `json:"running_time_in_nanos"`
+	Cancellable        bool            `json:"cancellable"`
+	Cancelled          bool            `json:"cancelled"`
+	ParentTaskID       string          `json:"parent_task_id"`
+	Status             json.RawMessage `json:"status,omitempty"`
+}
+
+// BulkByScrollStatus decodes the status of a reindex, update by query or delete by query task
+func (t TaskInfo) BulkByScrollStatus() (*BulkByScrollStatus, error) {
+	status := &BulkByScrollStatus{}
+	if len(t.Status) == 0 {
+		return status, nil
+	}
+
+	err := json.Unmarshal(t.Status, status)
+	if err != nil {
+		return &BulkByScrollStatus{}, err
+	}
+
+	return status, nil
+}
+
+// Task represents the state of a task as returned by the task management API.
+// Once completed, Response holds the response of the operation, e.g. a ForceMergeResponse for a force merge.
+type Task struct {
+	Completed bool            `json:"completed"`
+	Task      TaskInfo        `json:"task"`
+	Response  json.RawMessage `json:"response,omitempty"`
+	Error     *ErrorCause     `json:"error,omitempty"`
+}
+
+// BulkByScrollResponse decodes the response of a completed reindex, update by query or delete by query task
+func (t Task) BulkByScrollResponse() (*BulkByScrollResponse, error) {
+	response := &BulkByScrollResponse{}
+	if len(t.Response) == 0 {
+		return response, nil
+	}
+
+	err := json.Unmarshal(t.Response, response)
+	if err != nil {
+		return &BulkByScrollResponse{}, err
+	}
+
+	return response, nil
+}
+
+// CancelTaskResponse represents the result of the cancel operation of a task
+type CancelTaskResponse struct {
+	NodeFailures []ErrorCause `json:"node_failures"`
+	TaskFailures []ErrorCause `json:"task_failures"`
+	Nodes        map[string]struct {
+		Name  string              `json:"name"`
+		Tasks map[string]TaskInfo `json:"tasks"`
+	} `json:"nodes"`
+}
+
+func (c *client) GetTask(ctx context.Context, taskID string) (*Task, error) {
+	url := c.Host.String() + "/_tasks/" + taskID
+	response, err := sendHTTPRequestWithContext(ctx, "GET", url, nil, c.Timeout)
+	if err != nil {
+		return &Task{}, err
+	}
+
+	esResp := &Task{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &Task{}, err
+	}
+
+	return esResp, nil
+}
+
+func (c *client) CancelTask(ctx context.Context, taskID string) (*CancelTaskResponse, error) {
+	url := c.Host.String() + "/_tasks/" + taskID + "/_cancel"
+	response, err := sendHTTPRequestWithContext(ctx, "POST", url, nil, c.Timeout)
+	if err != nil {
+		return &CancelTaskResponse{}, err
+	}
+
+	esResp := &CancelTaskResponse{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &CancelTaskResponse{}, err
+	}
+
+	return esResp, nil
+}
@@ -0,0 +1,87 @@
+package elasticsearch_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/boes13/elasticsearch"
+)
+
+func TestGetTask(t *testing.T) {
+	helper := Test{}
+	ctx := context.Background()
+
+	//A running reindex and a completed force merge
+	server := NewRecorder(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/_tasks/node-1:1":
+			w.Write([]byte(`{"completed": false, "task": {"node": "node-1", "id": 1, "type": "transport", "action": "indices:data/write/reindex",
+				"cancellable": true, "status": {"total": 10, "created": 4, "batches": 1, "requests_per_second": -1}}}`))
+		case "/_tasks/node-1:2":
+			w.Write([]byte(`{"completed": true, "task": {"node": "node-1", "id": 2, "type": "transport", "action": "indices:admin/forcemerge",
+				"description": "Force-merge indices [products]", "cancellable": false},
+				"response": {"_shards": {"total": 2, "successful": 2, "failed": 0}}}`))
+		}
+	})
+	defer server.Close()
+	client := server.Client()
+
+	//Running reindex
+	task, err := client.GetTask(ctx, "node-1:1")
+	helper.OK(t, err)
+	helper.Assert(t, !task.Completed, "The reindex should still be running")
+	status, err := task.Task.BulkByScrollStatus()
+	helper.OK(t, err)
+	helper.Equals(t, int64(10), status.Total)
+	helper.Equals(t, int64(4), status.Created)
+	helper.Equals(t, float64(-1), status.RequestsPerSecond)
+
+	//Completed force merge
+	task, err = client.GetTask(ctx, "node-1:2")
+	helper.OK(t, err)
+	helper.Assert(t, task.Completed, "The force merge should be completed")
+	helper.Equals(t, "indices:admin/forcemerge", task.Task.Action)
+
+	var forceMerge elasticsearch.ForceMergeResponse
+	helper.OK(t, json.Unmarshal(task.Response, &forceMerge))
+	helper.Equals(t, 2, forceMerge.Shards.Successful)
+
+	status, err = task.Task.BulkByScrollStatus()
+	helper.OK(t, err)
+	helper.Equals(t, int64(0), status.Total)
+
+	helper.Equals(t, []string{"GET /_tasks/node-1:1", "GET /_tasks/node-1:2"}, server.Requests)
+}
+
+func TestCancelTask(t *testing.T) {
+	helper := Test{}
+	ctx := context.Background()
+
+	server := NewRecorder(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/_tasks/node-1:3/_cancel" {
+			Reply(http.StatusNotFound, `{"error": {"type": "resource_not_found_exception", "reason": "task [node-1:3] is not found"}, "status": 404}`)(w, r)
+			return
+		}
+		w.Write([]byte(`{"node_failures": [], "nodes": {"node-1": {"name": "es-1", "tasks": {
+			"node-1:1": {"node": "node-1", "id": 1, "type": "transport", "action": "indices:data/write/reindex", "cancellable": true, "cancelled": true}
+		}}}}`))
+	})
+	defer server.Close()
+	client := server.Client()
+
+	response, err := client.CancelTask(ctx, "node-1:1")
+	helper.OK(t, err)
+	helper.Equals(t, 0, len(response.NodeFailures))
+	task := response.Nodes["node-1"].Tasks["node-1:1"]
+	helper.Equals(t, "es-1", response.Nodes["node-1"].Name)
+	helper.Assert(t, task.Cancelled, "The task should be cancelled")
+	helper.Equals(t, "indices:data/write/reindex", task.Action)
+
+	_, err = client.CancelTask(ctx, "node-1:3")
+	helper.Assert(t, errors.Is(err, elasticsearch.ErrNotFound), "A missing task should return ErrNotFound, got %v", err)
+
+	helper.Equals(t, []string{"POST /_tasks/node-1:1/_cancel", "POST /_tasks/node-1:3/_cancel"}, server.Requests)
+}
@@ -0,0 +1,194 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+// Template represents the settings, mappings and aliases applied to the indices created from a template
+type Template struct {
+	Settings json.RawMessage            `json:"settings,omitempty"`
+	Mappings json.RawMessage            `json:"mappings,omitempty"`
+	Aliases  map[string]AliasDefinition `json:"aliases,omitempty"`
+}
+
+// IndexTemplate represents a legacy index template, applied to the new indices matching its patterns
+// by increasing order
+type IndexTemplate struct {
+	IndexPatterns []string `json:"index_patterns"`
+	Order         int      `json:"order,omitempty"`
+	Version       int      `json:"version,omitempty"`
+	Template
+}
+
+// ComposableIndexTemplate represents an index template built from component templates.
+// Only the template with the highest priority is applied to a new index.
+type ComposableIndexTemplate struct {
+	IndexPatterns []string               `json:"index_patterns"`
+	Priority      *int                   `json:"priority,omitempty"`
+	ComposedOf    []string               `json:"composed_of,omitempty"`
+	Version       int                    `json:"version,omitempty"`
+	Template      *Template              `json:"template,omitempty"`
+	DataStream    *struct{}              `json:"data_stream,omitempty"`
+	Meta          map[string]interface{} `json:"_meta,omitempty"`
+}
+
+// ComponentTemplate represents a reusable building block of composable index templates
+type ComponentTemplate struct {
+	Template Template               `json:"template"`
+	Version  int                    `json:"version,omitempty"`
+	Meta     map[string]interface{} `json:"_meta,omitempty"`
+}
+
+// SimulatedIndex represents the configuration a new index would get from the matching templates.
+// Overlapping lists the templates matching the index but with a lower priority.
+type SimulatedIndex struct {
+	Template    Template `json:"template"`
+	Overlapping []struct {
+		Name          string   `json:"name"`
+		IndexPatterns []string `json:"index_patterns"`
+	} `json:"overlapping"`
+}
+
+// putTemplate creates or updates the template at path and returns the acknowledgement
+func (c *client) putTemplate(ctx context.Context, path string, template interface{}) (*Response, error) {
+	body, err := json.Marshal(template)
+	if err != nil {
+		return &Response{}, err
+	}
+
+	url := c.Host.String() + path
+	response, err := sendHTTPRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(body), c.Timeout)
+	if err != nil {
+		return &Response{}, err
+	}
+
+	esResp := &Response{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &Response{}, err
+	}
+
+	return esResp, nil
+}
+
+// getTemplates decodes the templates of path matching the names into v, ErrNotFound is returned when none matches
+func (c *client) getTemplates(ctx context.Context, path string, names []string, v interface{}) error {
+	url := c.Host.String() + path
+	if len(names) > 0 {
+		url += "/" + strings.Join(names, ",")
+	}
+	response, err := sendHTTPRequestWithContext(ctx, "GET", url, nil, c.Timeout)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(response, v)
+}
+
+// deleteTemplate deletes the template at path and returns the acknowledgement
+func (c *client) deleteTemplate(ctx context.Context, path string) (*Response, error) {
+	url := c.Host.String() + path
+	response, err := sendHTTPRequestWithContext(ctx, "DELETE", url, nil, c.Timeout)
+	if err != nil {
+		return &Response{}, err
+	}
+
+	esResp := &Response{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &Response{}, err
+	}
+
+	return esResp, nil
+}
+
+func (c *client) PutTemplate(ctx context.Context, name string, template IndexTemplate) (*Response, error) {
+	return c.putTemplate(ctx, "/_template/"+name, template)
+}
+
+func (c *client) GetTemplates(ctx context.Context, names ...string) (map[string]IndexTemplate, error) {
+	templates := map[string]IndexTemplate{}
+	err := c.getTemplates(ctx, "/_template", names, &templates)
+	if err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+func (c *client) DeleteTemplate(ctx context.Context, name string) (*Response, error) {
+	return c.deleteTemplate(ctx, "/_template/"+name)
+}
+
+func (c *client) PutIndexTemplate(ctx context.Context, name string, template ComposableIndexTemplate) (*Response, error) {
+	return c.putTemplate(ctx, "/_index_template/"+name, template)
+}
+
+func (c *client) GetIndexTemplates(ctx context.Context, names ...string) (map[string]ComposableIndexTemplate, error) {
+	var esResp struct {
+		IndexTemplates []struct {
+			Name          string                  `json:"name"`
+			IndexTemplate ComposableIndexTemplate `json:"index_template"`
+		} `json:"index_templates"`
+	}
+	err := c.getTemplates(ctx, "/_index_template", names, &esResp)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make(map[string]ComposableIndexTemplate, len(esResp.IndexTemplates))
+	for _, template := range esResp.IndexTemplates {
+		templates[template.Name] = template.IndexTemplate
+	}
+	return templates, nil
+}
+
+func (c *client) DeleteIndexTemplate(ctx context.Context, name string) (*Response, error) {
+	return c.deleteTemplate(ctx, "/_index_template/"+name)
+}
+
+func (c *client) PutComponentTemplate(ctx context.Context, name string, template ComponentTemplate) (*Response, error) {
+	return c.putTemplate(ctx, "/_component_template/"+name, template)
+}
+
+func (c *client) GetComponentTemplates(ctx context.Context, names ...string) (map[string]ComponentTemplate, error) {
+	var esResp struct {
+		ComponentTemplates []struct {
+			Name              string            `json:"name"`
+			ComponentTemplate ComponentTemplate `json:"component_template"`
+		} `json:"component_templates"`
+	}
+	err := c.getTemplates(ctx, "/_component_template", names, &esResp)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make(map[string]ComponentTemplate, len(esResp.ComponentTemplates))
+	for _, template := range esResp.ComponentTemplates {
+		templates[template.Name] = template.ComponentTemplate
+	}
+	return templates, nil
+}
+
+func (c *client) DeleteComponentTemplate(ctx context.Context, name string) (*Response, error) {
+	return c.deleteTemplate(ctx, "/_component_template/"+name)
+}
+
+func (c *client) SimulateIndex(ctx context.Context, indexName string) (*SimulatedIndex, error) {
+	url := c.Host.String() + "/_index_template/_simulate_index/" + indexName
+	response, err := sendHTTPRequestWithContext(ctx, "POST", url, nil, c.Timeout)
+	if err != nil {
+		return &SimulatedIndex{}, err
+	}
+
+	esResp := &SimulatedIndex{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &SimulatedIndex{}, err
+	}
+
+	return esResp, nil
+}
@@ -0,0 +1,78 @@
+package elasticsearch_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/boes13/elasticsearch"
+)
+
+func TestLegacyTemplates(t *testing.T) {
+	helper := Test{}
+	ctx := context.Background()
+
+	server := NewRecorder(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/missing"):
+			Reply(http.StatusNotFound, `{}`)(w, r)
+		case r.Method == http.MethodGet:
+			w.Write([]byte(`{
+				"logs": {"order": 1, "version": 3, "index_patterns": ["logs-*"], "settings": {"index": {"number_of_shards": "1"}},
+					"mappings": {}, "aliases": {"logs-all": {}}},
+				"metrics": {"order": 0, "index_patterns": ["metrics-*"], "settings": {}, "mappings": {}, "aliases": {}}
+			}`))
+		default:
+			w.Write([]byte(`{"acknowledged": true}`))
+		}
+	})
+	defer server.Close()
+	client := server.Client()
+
+	//Put
+	response, err := client.PutTemplate(ctx, "logs", elasticsearch.IndexTemplate{
+		IndexPatterns: []string{"logs-*"},
+		Order:         1,
+		Version:       3,
+		Template: elasticsearch.Template{
+			Settings: json.RawMessage(`{"number_of_shards":1}`),
+			Aliases:  map[string]elasticsearch.AliasDefinition{"logs-all": {}},
+		},
+	})
+	helper.OK(t, err)
+	helper.Assert(t, response.Acknowledged, "The template has not been created")
+
+	//Get
+	templates, err := client.GetTemplates(ctx, "logs", "metrics")
+	helper.OK(t, err)
+	helper.Equals(t, 2, len(templates))
+	helper.Equals(t, []string{"logs-*"}, templates["logs"].IndexPatterns)
+	helper.Equals(t, 1, templates["logs"].Order)
+	helper.Equals(t, 3, templates["logs"].Version)
+	helper.Equals(t, `{"index": {"number_of_shards": "1"}}`, string(templates["logs"].Settings))
+
+	_, err = client.GetTemplates(ctx)
+	helper.OK(t, err)
+	_, err = client.GetTemplates(ctx, "missing")
+	helper.Assert(t, errors.Is(err, elasticsearch.ErrNotFound), "A missing template should return ErrNotFound, got %v", err)
+
+	//Delete
+	response, err = client.DeleteTemplate(ctx, "logs")
+	helper.OK(t, err)
+	helper.Assert(t, response.Acknowledged, "The template has not been deleted")
+	_, err = client.DeleteTemplate(ctx, "missing")
+	helper.Assert(t, errors.Is(err, elasticsearch.ErrNotFound), "A missing template should return ErrNotFound, got %v", err)
+
+	helper.Equals(t, []string{
+		"PUT /_template/logs",
+		"GET /_template/logs,metrics",
+		"GET /_template",
+		"GET /_template/missing",
+		"DELETE /_template/logs",
+		"DELETE /_template/missing",
+	}, server.Requests)
+	helper.Equals(t, `{"index_patterns":["logs-*"],"order":1,"version":3,"settings":{"number_of_shards":1},"aliases":{"logs-all":{}}}`, server.Bodies[0])
+}
@@ -0,0 +1,143 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+)
+
+// TermVectorsFilter selects the terms returned by a term vectors request based on their statistics
+type TermVectorsFilter struct {
+	MaxNumTerms   int `json:"max_num_terms,omitempty"`
+	MinTermFreq   int `json:"min_term_freq,omitempty"`
+	MaxTermFreq   int `json:"max_term_freq,omitempty"`
+	MinDocFreq    int `json:"min_doc_freq,omitempty"`
+	MaxDocFreq    int `json:"max_doc_freq,omitempty"`
+	MinWordLength int `json:"min_word_length,omitempty"`
+	MaxWordLength int `json:"max_word_length,omitempty"`
+}
+
+// TermVectorsRequest represents the term vectors to compute for a stored document, identified by ID,
+// or for an artificial document given in Doc
+type TermVectorsRequest struct {
+	Index string          `json:"-"` // only used by multi term vectors, defaults to the index of the request
+	ID    string          `json:"-"`
+	Doc   json.RawMessage `json:"doc,omitempty"`
+
+	Fields           []string           `json:"fields,omitempty"`
+	Offsets          *bool              `json:"offsets,omitempty"`
+	Positions        *bool              `json:"positions,omitempty"`
+	Payloads         *bool              `json:"payloads,omitempty"`
+	TermStatistics   bool               `json:"term_statistics,omitempty"`
+	FieldStatistics  *bool              `json:"field_statistics,omitempty"`
+	Filter           *TermVectorsFilter `json:"filter,omitempty"`
+	PerFieldAnalyzer map[string]string  `json:"per_field_analyzer,omitempty"`
+	Routing          string             `json:"routing,omitempty"`
+}
+
+// TermVectorToken represents an occurrence of a term
+type TermVectorToken struct {
+	Position    int    `json:"position"`
+	StartOffset int    `json:"start_offset"`
+	EndOffset   int    `json:"end_offset"`
+	Payload     string `json:"payload,omitempty"`
+}
+
+// TermVector represents the statistics of a term in a field.
+// DocFreq and TotalTermFreq are only set when term statistics are requested.
+type TermVector struct {
+	TermFreq      int               `json:"term_freq"`
+	DocFreq       int               `json:"doc_freq,omitempty"`
+	TotalTermFreq int64             `json:"ttf,omitempty"`
+	Score         float64           `json:"score,omitempty"`
+	Tokens        []TermVectorToken `json:"tokens,omitempty"`
+}
+
+// FieldTermVectors represents the term vectors of a field
+type FieldTermVectors struct {
+	FieldStatistics *struct {
+		SumDocFreq       int64 `json:"sum_doc_freq"`
+		DocCount         int64 `json:"doc_count"`
+		SumTotalTermFreq int64 `json:"sum_ttf"`
+	} `json:"field_statistics,omitempty"`
+	Terms map[string]TermVector `json:"terms"`
+}
+
+// TermVectorsResult represents the term vectors of a document, by field name
+type TermVectorsResult struct {
+	Index       string                      `json:"_index"`
+	Type        string                      `json:"_type"`
+	ID          string                      `json:"_id"`
+	Version     int                         `json:"_version"`
+	Found       bool                        `json:"found"`
+	Took        uint64                      `json:"took"`
+	TermVectors map[string]FieldTermVectors `json:"term_vectors"`
+	Error       *ErrorCause                 `json:"error,omitempty"`
+}
+
+// MultiTermVectorsResult represents the result of a multi term vectors operation, in the order of the requests
+type MultiTermVectorsResult struct {
+	Docs []TermVectorsResult `json:"docs"`
+}
+
+func (c *client) TermVectors(ctx context.Context, indexName string, req TermVectorsRequest, opts ...RequestOption) (*TermVectorsResult, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return &TermVectorsResult{}, err
+	}
+
+	path := c.Host.String() + "/" + indexName + "/_termvectors"
+	if req.ID != "" {
+		path += "/" + req.ID
+	}
+	url := buildURL(path, requestParams(opts))
+	response, err := sendHTTPRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body), c.Timeout)
+	if err != nil {
+		return &TermVectorsResult{}, err
+	}
+
+	esResp := &TermVectorsResult{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &TermVectorsResult{}, err
+	}
+
+	return esResp, nil
+}
+
+func (c *client) MultiTermVectors(ctx context.Context, indexName string, reqs []TermVectorsRequest, opts ...RequestOption) (*MultiTermVectorsResult, error) {
+	type multiTermVectorsDoc struct {
+		Index string `json:"_index,omitempty"`
+		ID    string `json:"_id,omitempty"`
+		TermVectorsRequest
+	}
+
+	docs := make([]multiTermVectorsDoc, len(reqs))
+	for i, req := range reqs {
+		docs[i] = multiTermVectorsDoc{Index: req.Index, ID: req.ID, TermVectorsRequest: req}
+	}
+	body, err := json.Marshal(struct {
+		Docs []multiTermVectorsDoc `json:"docs"`
+	}{docs})
+	if err != nil {
+		return &MultiTermVectorsResult{}, err
+	}
+
+	path := c.Host.String() + "/_mtermvectors"
+	if indexName != "" {
+		path = c.Host.String() + "/" + indexName + "/_mtermvectors"
+	}
+	url := buildURL(path, requestParams(opts))
+	response, err := sendHTTPRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body), c.Timeout)
+	if err != nil {
+		return &MultiTermVectorsResult{}, err
+	}
+
+	esResp := &MultiTermVectorsResult{}
+	err = json.Unmarshal(response, esResp)
+	if err != nil {
+		return &MultiTermVectorsResult{}, err
+	}
+
+	return esResp, nil
+}
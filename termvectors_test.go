@@ -0,0 +1,80 @@
+package elasticsearch_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/boes13/elasticsearch"
+)
+
+func TestTermVectors(t *testing.T) {
+	helper := Test{}
+	ctx := context.Background()
+
+	server := NewRecorder(Reply(http.StatusOK, `{"_index": "products", "_id": "1", "_version": 3, "found": true, "took": 2, "term_vectors": {"Name": {
+		"terms": {"jeans": {"term_freq": 2, "score": 1.5, "tokens": [{"position": 0, "start_offset": 0, "end_offset": 5}, {"position": 2, "start_offset": 11, "end_offset": 16}]}}
+	}}}`))
+	defer server.Close()
+	client := server.Client()
+
+	//Stored document
+	offsets := true
+	result, err := client.TermVectors(ctx, "products", elasticsearch.TermVectorsRequest{
+		ID:      "1",
+		Fields:  []string{"Name"},
+		Offsets: &offsets,
+		Filter:  &elasticsearch.TermVectorsFilter{MaxNumTerms: 3},
+	}, elasticsearch.WithRouting("user1"))
+	helper.OK(t, err)
+	helper.Assert(t, result.Found, "The document should be found")
+	helper.Equals(t, 3, result.Version)
+
+	term := result.TermVectors["Name"].Terms["jeans"]
+	helper.Equals(t, 2, term.TermFreq)
+	helper.Equals(t, 1.5, term.Score)
+	helper.Equals(t, 2, len(term.Tokens))
+	helper.Equals(t, 16, term.Tokens[1].EndOffset)
+	helper.Assert(t, result.TermVectors["Name"].FieldStatistics == nil, "No field statistics have been returned")
+
+	//Artificial document
+	_, err = client.TermVectors(ctx, "products", elasticsearch.TermVectorsRequest{
+		Doc:              json.RawMessage(`{"Name": "Jeans"}`),
+		PerFieldAnalyzer: map[string]string{"Name": "keyword"},
+	})
+	helper.OK(t, err)
+
+	helper.Equals(t, []string{"POST /products/_termvectors/1?routing=user1", "POST /products/_termvectors"}, server.Requests)
+	helper.Equals(t, []string{
+		`{"fields":["Name"],"offsets":true,"filter":{"max_num_terms":3}}`,
+		`{"doc":{"Name":"Jeans"},"per_field_analyzer":{"Name":"keyword"}}`,
+	}, server.Bodies)
+}
+
+func TestMultiTermVectors(t *testing.T) {
+	helper := Test{}
+
+	server := NewRecorder(Reply(http.StatusOK, `{"docs": [
+		{"_index": "products", "_id": "1", "found": true, "term_vectors": {"Name": {
+			"field_statistics": {"sum_doc_freq": 4, "doc_count": 2, "sum_ttf": 4},
+			"terms": {"jeans": {"term_freq": 1, "doc_freq": 2, "ttf": 2, "tokens": [{"position": 1, "start_offset": 5, "end_offset": 10}]}}
+		}}},
+		{"_index": "products", "_id": "_artificial", "found": true, "term_vectors": {}}
+	]}`))
+	defer server.Close()
+
+	client := server.Client()
+	result, err := client.MultiTermVectors(context.Background(), "products", []elasticsearch.TermVectorsRequest{
+		{ID: "1", Fields: []string{"Name"}, TermStatistics: true},
+		{Doc: json.RawMessage(`{"Name": "Blue jeans"}`)},
+	})
+	helper.OK(t, err)
+	helper.Equals(t, []string{"POST /products/_mtermvectors"}, server.Requests)
+	helper.Equals(t, []string{`{"docs":[{"_id":"1","fields":["Name"],"term_statistics":true},{"doc":{"Name":"Blue jeans"}}]}`}, server.Bodies)
+
+	term := result.Docs[0].TermVectors["Name"].Terms["jeans"]
+	helper.Equals(t, 2, term.DocFreq)
+	helper.Equals(t, 5, term.Tokens[0].StartOffset)
+	helper.Equals(t, int64(2), result.Docs[0].TermVectors["Name"].FieldStatistics.DocCount)
+}